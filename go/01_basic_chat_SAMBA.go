@@ -11,12 +11,15 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/moderation"
 )
 
 // Request and response structures for SambaNova API (OpenAI-compatible)
@@ -75,6 +78,22 @@ func main() {
 		MaxTokens:   100,
 	}
 
+	// Step 2b: Optional moderation pre-flight check
+	// LlamaGuard only runs on Groq, not SambaNova, so this step is opt-in:
+	// set GROQ_API_KEY alongside SAMBANOVA_API_KEY to have the outgoing
+	// message screened before it's sent. Without it, this example behaves
+	// exactly as before.
+	if groqKey := os.Getenv("GROQ_API_KEY"); groqKey != "" {
+		moderator := moderation.NewLlamaGuardModerator("https://api.groq.com/openai/v1", groqKey)
+		verdict, err := moderator.Check(context.Background(), "user", request.Messages[0].Content)
+		if err != nil {
+			fmt.Printf("Warning: moderation pre-flight check failed, continuing without it: %v\n", err)
+		} else if verdict.Flagged {
+			fmt.Printf("Blocked by moderation pre-flight check: %v\n", verdict.CategoryDescriptions())
+			os.Exit(1)
+		}
+	}
+
 	// Step 3: Marshal request to JSON
 	jsonData, err := json.Marshal(request)
 	if err != nil {
@@ -0,0 +1,103 @@
+package main
+
+// Example 1: Basic Chat, Unified
+//
+// 01_basic_chat_ANTHROPIC.go, 01_basic_chat_OPENAI.go,
+// 01_basic_chat_SAMBA.go, and 01_basic_chat_DEMETERICS.go each show one
+// provider's raw wire format side by side, on purpose - useful once, for
+// learning. This example shows the other side of that: pkg/completer
+// picks an pkg/llm.Provider by the DEFAULT_COMPLETER environment
+// variable (groq, demeterics, openai, anthropic, or mistral), so real
+// code can ask the same question of any provider by changing an env var
+// instead of a line of code.
+//
+// It also routes its request/response pair through pkg/audit, selected
+// by AUDIT_SINK (file|sqlite|grpc|stdout, defaulting to stdout), so
+// real code built on this example gets call history for free.
+//
+// The question is prepended with every turn already saved in
+// pkg/llm.History (~/.ai101/history.json by default), so a conversation
+// started here - or by any other example using pkg/llm.History - carries
+// over even across a provider switch.
+//
+// Run with:
+//
+//	export GROQ_API_KEY='...'        # or DEMETERICS_API_KEY, OPENAI_API_KEY, ...
+//	go run 01_basic_chat_UNIFIED.go
+//	DEFAULT_COMPLETER=anthropic ANTHROPIC_API_KEY='...' go run 01_basic_chat_UNIFIED.go
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/patdeg/ai101/go/pkg/audit"
+	"github.com/patdeg/ai101/go/pkg/completer"
+	"github.com/patdeg/ai101/go/pkg/llm"
+)
+
+func main() {
+	historyPath, err := llm.DefaultHistoryPath()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+	history, err := llm.LoadHistory(historyPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	req := llm.ChatRequest{
+		Messages:    append(history.Messages(), llm.Message{Role: "user", Content: "What is the capital of Switzerland?"}),
+		Temperature: 0.7,
+		MaxTokens:   100,
+	}
+
+	auditLogger, err := audit.FromEnv()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	provider, name, err := completer.New("")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Asking %s (set %s to try another provider)...\n\n", name, completer.EnvDefaultCompleter)
+	resp := ask(provider, req, auditLogger)
+
+	history.Append(name, req, resp)
+	if err := history.Save(historyPath); err != nil {
+		fmt.Fprintln(os.Stderr, "Warning: failed to save history:", err)
+	}
+}
+
+func ask(provider llm.Provider, req llm.ChatRequest, auditLogger audit.AuditLogger) llm.ChatResponse {
+	ctx := context.Background()
+
+	resp, err := provider.Chat(ctx, req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Answer:", resp.Text)
+	fmt.Println("Finish reason:", resp.FinishReason)
+	fmt.Printf("Usage: input=%d output=%d total=%d\n", resp.Usage.Input, resp.Usage.Output, resp.Usage.Total)
+
+	event := audit.Event{
+		Endpoint:         "01_basic_chat_UNIFIED",
+		Timestamp:        time.Now(),
+		PromptTokens:     resp.Usage.Input,
+		CompletionTokens: resp.Usage.Output,
+	}
+	if err := auditLogger.Log(ctx, event); err != nil {
+		fmt.Fprintln(os.Stderr, "Warning: failed to write audit log:", err)
+	}
+
+	return resp
+}
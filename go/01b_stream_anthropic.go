@@ -0,0 +1,188 @@
+package main
+
+// Example 1b: Streaming Chat with Anthropic
+//
+// The sibling of 01_basic_chat_ANTHROPIC.go: same Messages API, but with
+// "stream": true, consuming Anthropic's text/event-stream response as it
+// arrives instead of waiting for the whole reply.
+//
+// Anthropic's SSE frames are blank-line-delimited blocks of "event: TYPE"
+// and "data: {...}" lines (unlike the OpenAI-shaped APIs elsewhere in
+// this repo, which send one bare "data: {...}" line per chunk - compare
+// with pkg/groqclient/stream.go). This example walks through every event
+// type Anthropic sends for a single message: message_start,
+// content_block_start, content_block_delta (the actual text, printed as
+// it arrives), content_block_stop, message_delta (final stop_reason and
+// usage), and message_stop.
+//
+// It also demonstrates graceful cancellation: Ctrl-C cancels the request
+// context instead of killing the process mid-response, so the program
+// can still report whatever usage it saw before the interrupt.
+//
+// Run with: go run 01b_stream_anthropic.go
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+)
+
+type streamRequest struct {
+	Model     string             `json:"model"`
+	Messages  []anthropicMessage `json:"messages"`
+	MaxTokens int                `json:"max_tokens"`
+	Stream    bool               `json:"stream"`
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// sseEvent covers the fields used across every Anthropic streaming event
+// type; only the fields relevant to a given Type are populated.
+type sseEvent struct {
+	Type    string `json:"type"`
+	Message struct {
+		Usage anthropicUsage `json:"usage"`
+	} `json:"message"`
+	Delta struct {
+		Text       string `json:"text"`
+		StopReason string `json:"stop_reason"`
+	} `json:"delta"`
+	Usage anthropicUsage `json:"usage"`
+}
+
+type anthropicUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+func main() {
+	apiKey := os.Getenv("ANTHROPIC_API_KEY")
+	if apiKey == "" {
+		fmt.Println("Error: ANTHROPIC_API_KEY not set")
+		fmt.Println("Run: export ANTHROPIC_API_KEY='sk-ant-...'")
+		os.Exit(1)
+	}
+
+	// Canceling on SIGINT (Ctrl-C) instead of letting the process die
+	// mid-stream lets us still print the usage we've collected so far.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	request := streamRequest{
+		Model: "claude-haiku-4-5",
+		Messages: []anthropicMessage{
+			{Role: "user", Content: "Write a three-sentence story about a robot learning to paint."},
+		},
+		MaxTokens: 200,
+		Stream:    true,
+	}
+
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		fmt.Printf("Error marshaling request: %v\n", err)
+		os.Exit(1)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", "https://api.anthropic.com/v1/messages", bytes.NewReader(jsonData))
+	if err != nil {
+		fmt.Printf("Error creating request: %v\n", err)
+		os.Exit(1)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", apiKey)
+	httpReq.Header.Set("anthropic-version", "2023-06-01")
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		fmt.Printf("Error sending request: %v\n", err)
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+
+	fmt.Println("Claude's Answer (streaming):")
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	scanner.Split(splitSSEEvents)
+
+	var usage anthropicUsage
+	var stopReason string
+	for scanner.Scan() {
+		event, err := parseSSEEvent(scanner.Bytes())
+		if err != nil {
+			fmt.Printf("\nError parsing event: %v\n", err)
+			os.Exit(1)
+		}
+		if event == nil {
+			continue // keep-alive or a line we don't need (e.g. "event: ...")
+		}
+
+		switch event.Type {
+		case "message_start":
+			usage = event.Message.Usage
+		case "content_block_delta":
+			fmt.Print(event.Delta.Text)
+		case "message_delta":
+			if event.Delta.StopReason != "" {
+				stopReason = event.Delta.StopReason
+			}
+			usage.OutputTokens = event.Usage.OutputTokens
+		case "message_stop":
+			// nothing left to do; the loop ends when the scanner runs dry
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if ctx.Err() != nil {
+			fmt.Println("\n\n(canceled by user)")
+		} else {
+			fmt.Printf("\nError reading stream: %v\n", err)
+		}
+	}
+
+	fmt.Println()
+	fmt.Println(strings.Repeat("=", 50))
+	fmt.Println("Stop reason:", stopReason)
+	fmt.Printf("Token usage: input=%d output=%d total=%d\n",
+		usage.InputTokens, usage.OutputTokens, usage.InputTokens+usage.OutputTokens)
+}
+
+// splitSSEEvents is a bufio.SplitFunc that breaks Anthropic's SSE stream
+// into blank-line-delimited event blocks instead of individual lines.
+func splitSSEEvents(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if i := bytes.Index(data, []byte("\n\n")); i >= 0 {
+		return i + 2, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// parseSSEEvent extracts the "data: {...}" line from one event block and
+// unmarshals it. It returns a nil event (and nil error) for a block that
+// carries no data line, which happens for SSE comments/keep-alives.
+func parseSSEEvent(block []byte) (*sseEvent, error) {
+	for _, line := range strings.Split(string(block), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		var event sseEvent
+		if err := json.Unmarshal([]byte(payload), &event); err != nil {
+			return nil, fmt.Errorf("failed to parse SSE data: %w (raw: %s)", err, payload)
+		}
+		return &event, nil
+	}
+	return nil, nil
+}
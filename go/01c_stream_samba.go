@@ -0,0 +1,122 @@
+package main
+
+// Example 1c: Streaming Chat with SambaNova
+//
+// The stream:true sibling of 01_basic_chat_SAMBA.go: same request, same
+// OpenAI-compatible endpoint, but read as a text/event-stream via
+// pkg/sse.Reader instead of io.ReadAll-ing the whole body, so tokens
+// print as they arrive instead of after the full response lands.
+//
+// Run with: export SAMBANOVA_API_KEY='your-key-here'; go run 01c_stream_samba.go
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/sse"
+)
+
+type sambaStreamRequest struct {
+	Model       string               `json:"model"`
+	Messages    []sambaStreamMessage `json:"messages"`
+	Temperature float64              `json:"temperature,omitempty"`
+	MaxTokens   int                  `json:"max_tokens,omitempty"`
+	Stream      bool                 `json:"stream"`
+}
+
+type sambaStreamMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// sambaStreamChunk mirrors one `data: {...}` line of an OpenAI-compatible
+// chat.completion.chunk SSE event.
+type sambaStreamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+		FinishReason *string `json:"finish_reason"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func main() {
+	apiKey := os.Getenv("SAMBANOVA_API_KEY")
+	if apiKey == "" {
+		fmt.Println("Error: SAMBANOVA_API_KEY not set")
+		fmt.Println("Run: export SAMBANOVA_API_KEY='your-key-here'")
+		os.Exit(1)
+	}
+
+	reqBody := sambaStreamRequest{
+		Model: "Meta-Llama-3.1-8B-Instruct",
+		Messages: []sambaStreamMessage{
+			{Role: "user", Content: "What is the capital of Switzerland?"},
+		},
+		Temperature: 0.7,
+		MaxTokens:   100,
+		Stream:      true,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		fmt.Printf("Error marshaling request: %v\n", err)
+		os.Exit(1)
+	}
+
+	req, err := http.NewRequest("POST", "https://api.sambanova.ai/v1/chat/completions", bytes.NewBuffer(jsonData))
+	if err != nil {
+		fmt.Printf("Error creating request: %v\n", err)
+		os.Exit(1)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		fmt.Printf("Error sending request: %v\n", err)
+		os.Exit(1)
+	}
+	defer resp.Body.Close()
+
+	fmt.Println("AI's Answer (streaming):")
+
+	reader := sse.NewReader(resp.Body)
+	for {
+		event, err := reader.Next()
+		if errors.Is(err, sse.ErrStreamDone) {
+			break
+		}
+		if err != nil {
+			fmt.Printf("\nError reading stream: %v\n", err)
+			os.Exit(1)
+		}
+
+		if event.Event == "error" {
+			fmt.Printf("\nmid-stream error event: %s\n", event.Data)
+			os.Exit(1)
+		}
+
+		var chunk sambaStreamChunk
+		if err := json.Unmarshal([]byte(event.Data), &chunk); err != nil {
+			fmt.Printf("\nError parsing chunk: %v\n", err)
+			os.Exit(1)
+		}
+		if chunk.Error != nil {
+			fmt.Printf("\nAPI error: %s\n", chunk.Error.Message)
+			os.Exit(1)
+		}
+		for _, choice := range chunk.Choices {
+			fmt.Print(choice.Delta.Content)
+		}
+	}
+	fmt.Println()
+}
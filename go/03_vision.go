@@ -9,11 +9,13 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"image"
 	"io"
 	"net/http"
 	"os"
-	"path/filepath"
 	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/blurhash"
 )
 
 // Request structures for vision API
@@ -75,15 +77,19 @@ type Usage struct {
 //   - Base64 encoding for binary data transmission
 //   - Building multimodal messages (text + images)
 //   - Reading files and detecting MIME types in Go
+//   - Generating a BlurHash placeholder alongside the request
 //
 // What you'll learn:
 //   - How to read and encode image files
 //   - How to construct data URLs for images
 //   - How to create content arrays with mixed types
 //   - How AI models can analyze visual content
+//   - How to compute a compact blurred-preview string for a UI to show
+//     while the real image loads
 //
 // Expected output:
 //   - Image file information (size, encoding)
+//   - A BlurHash placeholder string
 //   - Detailed AI description of the image content
 //   - Token usage (images use more tokens than text!)
 //
@@ -103,60 +109,101 @@ func main() {
 	// Using the shared test image from the root directory
 	imagePath := "../test_image.jpg"
 
-	// Step 3: Read the image file from disk
-	// os.ReadFile reads the entire file into memory as a byte slice ([]byte)
-	// This is convenient for small files like images
-	imageData, err := os.ReadFile(imagePath)
+	// Step 3: Open the image file from disk
+	// os.Open just opens the file handle - nothing is read into memory yet
+	imageFile, err := os.Open(imagePath)
 
-	// Check if file was read successfully
+	// Check if file was opened successfully
 	if err != nil {
 		fmt.Printf("Error: Image file '%s' not found\n", imagePath)
 		fmt.Println("\nTo create a test image, run:")
 		fmt.Println("  curl -o test_image.jpg https://picsum.photos/400/300")
 		os.Exit(1)
 	}
+	defer imageFile.Close()
 
-	// Step 4: Encode image to base64
-	// Base64 converts binary data (image bytes) to text format
-	// This is necessary because JSON can't contain raw binary data
-	// base64.StdEncoding.EncodeToString converts []byte to a base64 string
-	imageBase64 := base64.StdEncoding.EncodeToString(imageData)
+	fileInfo, err := imageFile.Stat()
+	if err != nil {
+		fmt.Printf("Error reading image file info: %v\n", err)
+		os.Exit(1)
+	}
 
-	// Display information about the image and encoding
-	// This helps understand the size overhead of base64 encoding
-	fmt.Printf("Image loaded: %s\n", imagePath)
-	fmt.Printf("Image size: %.2f KB\n", float64(len(imageData))/1024)       // Original size
-	fmt.Printf("Base64 size: %.2f KB\n", float64(len(imageBase64))/1024)   // Encoded size
-	fmt.Println("(Base64 is ~33% larger than original)\n")
+	// Step 4: Detect MIME type by sniffing the file's content
+	// http.DetectContentType looks at the first bytes ("magic numbers") of
+	// the file rather than trusting the filename extension, which can be
+	// missing, wrong, or simply renamed. We only need up to 512 bytes for
+	// this, which we then feed back in front of the rest of the file via
+	// io.MultiReader so the decode pass in Step 5 doesn't re-read them.
+	peekBuf := make([]byte, 512)
+	peeked, err := io.ReadFull(imageFile, peekBuf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		fmt.Printf("Error reading image file: %v\n", err)
+		os.Exit(1)
+	}
+	peekBuf = peekBuf[:peeked]
 
-	// Step 5: Detect MIME type from file extension
-	// MIME type tells the API what kind of image this is
-	// filepath.Ext extracts the file extension (e.g., ".jpg")
-	// strings.ToLower ensures we can match regardless of case (.JPG vs .jpg)
-	ext := strings.ToLower(filepath.Ext(imagePath))
 	var mimeType string
-
-	// Match extension to MIME type using a switch statement
-	switch ext {
-	case ".png":
-		mimeType = "image/png"
-	case ".jpg", ".jpeg": // Multiple cases can share the same action
+	switch http.DetectContentType(peekBuf) {
+	case "image/png", "image/gif", "image/webp":
+		mimeType = http.DetectContentType(peekBuf)
+	case "image/jpeg":
 		mimeType = "image/jpeg"
-	case ".gif":
-		mimeType = "image/gif"
-	case ".webp":
-		mimeType = "image/webp"
 	default:
-		mimeType = "image/jpeg" // Fallback if extension is unknown
+		mimeType = "image/jpeg" // Fallback if content isn't a recognized image type
+	}
+
+	// Step 5: Decode the image once to compute a BlurHash placeholder
+	// BlurHash needs the decoded pixel grid, not just the raw bytes, so
+	// this is a separate pass from the base64 streaming below. We seek
+	// back to the start afterward so that pass can still stream the file
+	// from disk rather than reusing this decoded copy.
+	var placeholder string
+	decodedImage, _, decodeErr := image.Decode(io.MultiReader(bytes.NewReader(peekBuf), imageFile))
+	if decodeErr != nil {
+		placeholder = fmt.Sprintf("(unavailable: %v)", decodeErr)
+	} else if placeholder, err = blurhash.Encode(decodedImage, 4, 3); err != nil {
+		placeholder = fmt.Sprintf("(unavailable: %v)", err)
 	}
 
-	// Step 6: Build data URL
+	if _, err := imageFile.Seek(0, io.SeekStart); err != nil {
+		fmt.Printf("Error rewinding image file: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 6: Encode image to base64 while streaming it from disk
+	// os.ReadFile + base64.StdEncoding.EncodeToString would hold the whole
+	// raw file AND the whole base64 string in memory at once. Instead,
+	// base64.NewEncoder wraps a strings.Builder and io.Copy streams the
+	// file through it in small chunks, so only one full copy (the base64
+	// text, which the JSON body needs anyway) ever sits in memory.
+	var base64Builder strings.Builder
+	encoder := base64.NewEncoder(base64.StdEncoding, &base64Builder)
+	if _, err := io.Copy(encoder, imageFile); err != nil {
+		fmt.Printf("Error encoding image: %v\n", err)
+		os.Exit(1)
+	}
+	if err := encoder.Close(); err != nil {
+		fmt.Printf("Error finishing image encoding: %v\n", err)
+		os.Exit(1)
+	}
+	imageBase64 := base64Builder.String()
+
+	// Display information about the image and encoding
+	// This helps understand the size overhead of base64 encoding
+	fmt.Printf("Image loaded: %s\n", imagePath)
+	fmt.Printf("Detected MIME type: %s\n", mimeType)
+	fmt.Printf("Image size: %.2f KB\n", float64(fileInfo.Size())/1024)      // Original size
+	fmt.Printf("Base64 size: %.2f KB\n", float64(len(imageBase64))/1024)   // Encoded size
+	fmt.Println("(Base64 is ~33% larger than original)\n")
+	fmt.Printf("BlurHash placeholder: %s\n\n", placeholder)
+
+	// Step 7: Build data URL
 	// Data URL format: data:<mime-type>;base64,<base64-encoded-data>
 	// This embeds the entire image directly in the request
 	// fmt.Sprintf works like printf but returns a string instead of printing
 	imageURL := fmt.Sprintf("data:%s;base64,%s", mimeType, imageBase64)
 
-	// Step 7: Create the request with multimodal content
+	// Step 8: Create the request with multimodal content
 	// The Content field is now an ARRAY of ContentItem structs
 	// This allows us to mix text and images in a single message
 	request := VisionRequest{
@@ -184,7 +231,7 @@ func main() {
 		MaxTokens:   500, // Images need more tokens for detailed descriptions
 	}
 
-	// Step 8: Convert struct to JSON
+	// Step 9: Convert struct to JSON
 	// Marshal serializes our complex nested structure to JSON
 	jsonData, err := json.Marshal(request)
 
@@ -194,7 +241,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Step 9: Create HTTP request
+	// Step 10: Create HTTP request
 	// Build POST request with our JSON payload
 	req, err := http.NewRequest(
 		"POST",                                            // HTTP method
@@ -208,12 +255,12 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Step 10: Set HTTP headers
+	// Step 11: Set HTTP headers
 	// Standard headers for JSON API requests
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+apiKey)
 
-	// Step 11: Send the HTTP request
+	// Step 12: Send the HTTP request
 	// Execute the request using an HTTP client
 	client := &http.Client{}
 	resp, err := client.Do(req)
@@ -227,7 +274,7 @@ func main() {
 	// Schedule response cleanup when function exits
 	defer resp.Body.Close()
 
-	// Step 12: Read the response body
+	// Step 13: Read the response body
 	// Read all bytes from the HTTP response
 	body, err := io.ReadAll(resp.Body)
 
@@ -237,7 +284,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Step 13: Parse the JSON response
+	// Step 14: Parse the JSON response
 	// Declare variable to hold the parsed response
 	var response ChatResponse
 
@@ -251,7 +298,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Step 14: Display results
+	// Step 15: Display results
 	// Show the AI's analysis of the image
 
 	// Print decorative separator
@@ -302,6 +349,11 @@ func main() {
 //   base64.StdEncoding.EncodeToString([]byte) // []byte → base64 string
 //   base64.StdEncoding.DecodeString(string)   // base64 string → []byte
 //   base64.StdEncoding.Encode(dst, src)       // Encode to existing buffer
+//   base64.NewEncoder(enc, w)                 // Streaming encoder that
+//                                              // writes encoded output to w
+//                                              // as you io.Copy/Write into it,
+//                                              // instead of needing the whole
+//                                              // input in memory first
 //
 // Example:
 //   original := []byte("Hello")
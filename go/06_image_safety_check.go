@@ -20,77 +20,22 @@ Usage:
 
 Environment:
     GROQ_API_KEY - Your Groq API key
+
+Note: the HTTP plumbing lives in pkg/groqclient, shared with the other
+chat-completion examples.
 */
 
 import (
-	"bytes"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"strings"
-)
-
-// ContentItem represents text or image content in a message
-type ContentItem struct {
-	Type     string    `json:"type"`
-	Text     string    `json:"text,omitempty"`
-	ImageURL *ImageURL `json:"image_url,omitempty"`
-}
-
-// ImageURL contains the image data URL
-type ImageURL struct {
-	URL string `json:"url"`
-}
-
-// Message represents a chat message with multi-modal content
-type Message struct {
-	Role    string        `json:"role"`
-	Content []ContentItem `json:"content"`
-}
-
-// ChatRequest represents the API request structure
-type ChatRequest struct {
-	Model     string    `json:"model"`
-	Messages  []Message `json:"messages"`
-	MaxTokens int       `json:"max_tokens"`
-}
-
-// Choice represents a response choice
-type Choice struct {
-	Message struct {
-		Role    string `json:"role"`
-		Content string `json:"content"`
-	} `json:"message"`
-	Index        int    `json:"index"`
-	FinishReason string `json:"finish_reason"`
-}
-
-// Usage represents token usage statistics
-type Usage struct {
-	PromptTokens     int `json:"prompt_tokens"`
-	CompletionTokens int `json:"completion_tokens"`
-	TotalTokens      int `json:"total_tokens"`
-}
 
-// ChatResponse represents the API response
-type ChatResponse struct {
-	ID      string   `json:"id"`
-	Object  string   `json:"object"`
-	Created int64    `json:"created"`
-	Model   string   `json:"model"`
-	Choices []Choice `json:"choices"`
-	Usage   Usage    `json:"usage"`
-	Error   *struct {
-		Message string `json:"message"`
-		Type    string `json:"type"`
-	} `json:"error,omitempty"`
-}
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
 
 // checkImageSafety checks if an image is safe using LlamaGuard Vision
-func checkImageSafety(imagePath string) (*ChatResponse, error) {
+func checkImageSafety(imagePath string) (*groqclient.ChatCompletionResponse, error) {
 	// Step 1: Check API key
 	apiKey := os.Getenv("GROQ_API_KEY")
 	if apiKey == "" {
@@ -100,86 +45,34 @@ func checkImageSafety(imagePath string) (*ChatResponse, error) {
 	// Step 2: Load and encode the image
 	fmt.Printf("Loading image: %s\n", imagePath)
 
-	// Read the image file
 	imageData, err := os.ReadFile(imagePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read image: %w", err)
 	}
 
-	// Encode to base64
 	imageBase64 := base64.StdEncoding.EncodeToString(imageData)
+	mimeType := groqclient.SniffImageMimeType(imageData)
 
-	// Display image info
 	fmt.Printf("Image size: %d bytes\n", len(imageData))
 	fmt.Printf("Base64 size: %d characters\n", len(imageBase64))
+	fmt.Printf("Detected MIME type: %s\n", mimeType)
 
 	// Step 3: Prepare the API request
-	request := ChatRequest{
+	client := groqclient.New(groqclient.GroqBaseURL, apiKey)
+	request := groqclient.ChatCompletionRequest{
 		Model: "meta-llama/llama-guard-4-12b",
-		Messages: []Message{
+		Messages: []groqclient.Message{
 			{
-				Role: "user",
-				Content: []ContentItem{
-					{
-						Type: "text",
-						Text: "Check this image for safety",
-					},
-					{
-						Type: "image_url",
-						ImageURL: &ImageURL{
-							URL: fmt.Sprintf("data:image/jpeg;base64,%s", imageBase64),
-						},
-					},
-				},
+				Role:    "user",
+				Content: groqclient.ImageContentParts("Check this image for safety", imageData, mimeType),
 			},
 		},
 		MaxTokens: 100, // Safety responses are typically short
 	}
 
-	// Convert request to JSON
-	jsonData, err := json.Marshal(request)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
 	// Step 4: Make the API request
 	fmt.Println("\nChecking image safety with LlamaGuard...")
-
-	req, err := http.NewRequest("POST", "https://api.groq.com/openai/v1/chat/completions", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Set headers
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Content-Type", "application/json")
-
-	// Make the request
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Read the response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	// Step 5: Parse the response
-	var result ChatResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	// Check for API errors
-	if result.Error != nil {
-		return nil, fmt.Errorf("API error: %s", result.Error.Message)
-	}
-
-	return &result, nil
+	return client.CreateChatCompletion(request)
 }
 
 // SafetyResult represents the interpretation of a safety check
@@ -190,7 +83,7 @@ type SafetyResult struct {
 }
 
 // interpretSafetyResult interprets the LlamaGuard safety check result
-func interpretSafetyResult(response *ChatResponse) SafetyResult {
+func interpretSafetyResult(response *groqclient.ChatCompletionResponse) SafetyResult {
 	// Check if we have a valid response
 	if response == nil || len(response.Choices) == 0 {
 		return SafetyResult{
@@ -330,4 +223,4 @@ func main() {
 	fmt.Println("- Combine with text safety checks for complete moderation")
 	fmt.Println("- Base64 encoding increases size by ~33%")
 	fmt.Println("- Go's encoding/base64 package handles the conversion")
-}
\ No newline at end of file
+}
@@ -6,83 +6,16 @@ package main
 // Demonstrates THREE tests with probability scoring
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"strings"
-)
-
-// Request structures
-type ChatRequest struct {
-	// Model specifies which security model to use
-	// "meta-llama/llama-prompt-guard-2-86m" - tiny, fast attack detector (86M params)
-	Model string `json:"model"`
-
-	// Messages contains the user input to scan for attacks
-	// Typically just one message with role="user"
-	Messages []Message `json:"messages"`
-
-	// MaxTokens can be small (10-50) since responses are just probability scores
-	MaxTokens int `json:"max_tokens,omitempty"`
-}
-
-type Message struct {
-	// Role is usually "user" for inputs to check
-	Role string `json:"role"`
-
-	// Content is the user's message to scan for:
-	// - Jailbreak attempts (trying to bypass AI safety rules)
-	// - Injection attacks (trying to manipulate AI behavior)
-	Content string `json:"content"`
-}
-
-// Response structures
-type ChatResponse struct {
-	// ID uniquely identifies this security scan
-	ID string `json:"id"`
-
-	// Object type is "chat.completion"
-	Object string `json:"object"`
-
-	// Created timestamp
-	Created int64 `json:"created"`
-
-	// Model confirms which security scanner was used
-	Model string `json:"model"`
 
-	// Choices contains the attack probability score
-	Choices []Choice `json:"choices"`
-
-	// Usage shows tokens (Prompt Guard is very cheap - 86M tiny model!)
-	Usage Usage `json:"usage"`
-}
-
-type Choice struct {
-	// Index of this choice (always 0)
-	Index int `json:"index"`
-
-	// Message contains the probability score in content
-	// Format: floating point number as string (e.g., "0.95" or "0.02")
-	// Range: 0.0 (definitely benign) to 1.0 (definitely attack)
-	Message Message `json:"message"`
-
-	// FinishReason is typically "stop"
-	FinishReason string `json:"finish_reason"`
-}
-
-type Usage struct {
-	// PromptTokens is the input text being scanned
-	PromptTokens int `json:"prompt_tokens"`
+	"github.com/patdeg/ai101/go/pkg/promptguard"
+)
 
-	// CompletionTokens is tiny (just a number like "0.95")
-	CompletionTokens int `json:"completion_tokens"`
-
-	// TotalTokens is very small - Prompt Guard is extremely fast and cheap
-	TotalTokens int `json:"total_tokens"`
-}
+// Note: the HTTP plumbing now lives in pkg/promptguard, shared with
+// 05_prompt_guard.go. promptguard.Verdict.Score carries the same
+// probability (0.0 benign .. 1.0 attack) this file used to parse by hand.
 
 // MAIN FUNCTION OVERVIEW:
 // =======================
@@ -130,71 +63,16 @@ func main() {
 		injection float64
 	}
 
+	guard := promptguard.New("https://api.demeterics.com/groq/v1", apiKey)
+
 	// Step 3: Helper function to check message with Prompt Guard
 	checkPromptGuard := func(message, testName string) float64 {
-		// Create request for Prompt Guard model
-		request := ChatRequest{
-			Model: "meta-llama/llama-prompt-guard-2-86m",
-			Messages: []Message{
-				{
-					Role:    "user",
-					Content: message,
-				},
-			},
-			MaxTokens: 100, // Responses are probability scores
-		}
-
-		// Convert struct to JSON
-		jsonData, err := json.Marshal(request)
-		if err != nil {
-			fmt.Printf("Error creating JSON: %v\n", err)
-			os.Exit(1)
-		}
-
-		// Create HTTP request
-		req, err := http.NewRequest(
-			"POST",
-			"https://api.demeterics.com/groq/v1/chat/completions",
-			bytes.NewBuffer(jsonData),
-		)
-		if err != nil {
-			fmt.Printf("Error creating request: %v\n", err)
-			os.Exit(1)
-		}
-
-		// Set HTTP headers
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Authorization", "Bearer "+apiKey)
-
-		// Send the HTTP request
-		client := &http.Client{}
-		resp, err := client.Do(req)
+		verdict, err := guard.Scan(message)
 		if err != nil {
-			fmt.Printf("Error sending request: %v\n", err)
+			fmt.Printf("Error scanning message: %v\n", err)
 			os.Exit(1)
 		}
-		defer resp.Body.Close()
-
-		// Read the response body
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Printf("Error reading response: %v\n", err)
-			os.Exit(1)
-		}
-
-		// Parse the JSON response
-		var response ChatResponse
-		err = json.Unmarshal(body, &response)
-		if err != nil {
-			fmt.Printf("Error parsing JSON: %v\n", err)
-			fmt.Printf("Raw response: %s\n", string(body))
-			os.Exit(1)
-		}
-
-		// Extract and parse the probability score
-		scoreStr := strings.TrimSpace(response.Choices[0].Message.Content)
-		var score float64
-		fmt.Sscanf(scoreStr, "%f", &score)
+		score := verdict.Score
 
 		// Display results
 		fmt.Println(strings.Repeat("=", 60))
@@ -208,7 +86,7 @@ func main() {
 		// Interpret the probability score
 		// Score < 0.5 = benign (safe message)
 		// Score > 0.5 = attack (jailbreak or injection)
-		if score < 0.5 {
+		if !verdict.IsAttack() {
 			fmt.Printf("✓ BENIGN (Score: %f)\n", score)
 			fmt.Println("  Score is close to 0.0 = Safe, normal message")
 		} else {
@@ -217,11 +95,6 @@ func main() {
 			fmt.Println("  The user is trying to bypass AI safety rules or inject malicious instructions")
 			fmt.Println("  ACTION: Block this request")
 		}
-
-		fmt.Println()
-		fmt.Println("Raw API Response:")
-		jsonBytes, _ := json.MarshalIndent(response, "", "  ")
-		fmt.Println(string(jsonBytes))
 		fmt.Println()
 
 		return score
@@ -0,0 +1,85 @@
+package main
+
+// Example 7b: Audio Translation - Groq's /audio/translations endpoint,
+// the multilingual-input sibling of 08_whisper.go's /audio/transcriptions:
+// whatever language the audio is spoken in, the returned text is always
+// English.
+//
+// Demonstrates:
+//   - pkg/whisper.AudioClient.Translate, the same multipart upload flow
+//     as Transcribe but hitting the translations endpoint
+//   - pkg/whisper.SplitAudioByDuration for audio files over Groq's 25 MB
+//     limit, splitting on MP3/WAV frame boundaries (no ffmpeg) with a
+//     short overlap so a word at a chunk boundary isn't lost
+//
+// Run:
+//
+//	export GROQ_API_KEY='your_key_here'
+//	go run 07b_translate.go
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/whisper"
+)
+
+func main() {
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY environment variable not set")
+		fmt.Fprintln(os.Stderr, "Run: export GROQ_API_KEY='your_key_here'")
+		os.Exit(1)
+	}
+
+	audioFilePath := "../gettysburg.mp3"
+	if _, err := os.Stat(audioFilePath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: audio file not found: %s\n", audioFilePath)
+		os.Exit(1)
+	}
+
+	fmt.Println("============================================================")
+	fmt.Println("Audio Translation with Groq Whisper")
+	fmt.Println("============================================================")
+	fmt.Printf("File: %s\n\n", audioFilePath)
+
+	data, err := os.ReadFile(audioFilePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading audio file: %v\n", err)
+		os.Exit(1)
+	}
+
+	client := whisper.NewAudioClient(apiKey)
+
+	// Files under whisper.DefaultMaxBytes go through untouched; larger
+	// ones are split into overlapping chunks and translated one at a
+	// time, exactly as a caller would for a long podcast episode.
+	chunks, err := whisper.SplitAudioByDuration(data, whisper.DefaultMaxBytes, 2.0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error splitting audio: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Split into %d chunk(s) for translation\n\n", len(chunks))
+
+	for i, chunk := range chunks {
+		chunkPath := fmt.Sprintf("%s.translate_chunk_%d.mp3", audioFilePath, i)
+		if err := os.WriteFile(chunkPath, chunk, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing chunk %d: %v\n", i, err)
+			os.Exit(1)
+		}
+		defer os.Remove(chunkPath)
+
+		response, err := client.Translate(whisper.Request{
+			AudioPath: chunkPath,
+			Format:    whisper.FormatVerboseJSON,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error translating chunk %d: %v\n", i, err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("--- Chunk %d/%d ---\n", i+1, len(chunks))
+		fmt.Println(response.Text)
+		fmt.Println()
+	}
+}
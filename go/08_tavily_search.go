@@ -31,6 +31,8 @@ import (
 	"io"
 	"net/http"
 	"os"
+
+	"github.com/patdeg/ai101/go/pkg/httpx"
 )
 
 // TavilySearchRequest represents the API request structure
@@ -119,7 +121,13 @@ func main() {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", apiKey))
 
-	client := &http.Client{}
+	// Built via pkg/httpx so GROQ_CLIENT_CERT/GROQ_CLIENT_KEY/GROQ_CA_BUNDLE
+	// enable mutual TLS to a corporate proxy the same way they do for Groq.
+	client, err := httpx.DefaultClient()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error building HTTP client: %v\n", err)
+		os.Exit(1)
+	}
 	resp, err := client.Do(req)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error making request: %v\n", err)
@@ -0,0 +1,95 @@
+package main
+
+// Example 8: Text-to-Speech - the inverse of Whisper transcription
+// (see 08_whisper.go and pkg/whisper): instead of uploading audio and
+// getting text back, we post text and get audio back.
+//
+// Demonstrates:
+//   - Calling Groq's /audio/speech endpoint with model/input/voice/
+//     response_format (pkg/tts.Synthesize)
+//   - Streaming the binary response straight to a file via io.Copy
+//   - Chunking long input on sentence boundaries (pkg/tts.SplitIntoChunks)
+//     and concatenating the resulting audio chunks into one file
+//   - Reporting estimated cost per 1M input characters
+//
+// Run:
+//
+//	export GROQ_API_KEY='your_key_here'
+//	go run 08_tts.go
+//	go run 08_tts.go -voice Arista-PlayAI -format wav -max_chars 500 -out speech.wav
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/tts"
+)
+
+// costPerMillionChars is Groq's playai-tts pricing, quoted per 1M input
+// characters. Check https://groq.com/pricing for current rates.
+const costPerMillionChars = 50.0
+
+func main() {
+	voice := flag.String("voice", "Fritz-PlayAI", "voice to synthesize with")
+	format := flag.String("format", "mp3", "output format: mp3, wav, opus, or flac")
+	maxChars := flag.Int("max_chars", tts.DefaultMaxChars, "max characters per TTS request before chunking")
+	out := flag.String("out", "speech.mp3", "output audio file")
+	flag.Parse()
+
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY environment variable not set")
+		fmt.Fprintln(os.Stderr, "Run: export GROQ_API_KEY='your_key_here'")
+		os.Exit(1)
+	}
+
+	text := `Four score and seven years ago our fathers brought forth on this continent a new nation, conceived in liberty, and dedicated to the proposition that all men are created equal. Now we are engaged in a great civil war, testing whether that nation, or any nation so conceived and so dedicated, can long endure. We are met on a great battlefield of that war.`
+
+	chunks := tts.SplitIntoChunks(text, *maxChars)
+
+	fmt.Println("============================================================")
+	fmt.Println("Text-to-Speech with Groq")
+	fmt.Println("============================================================")
+	fmt.Println("Model:", tts.DefaultModel)
+	fmt.Printf("Voice: %s\n", *voice)
+	fmt.Printf("Format: %s\n", *format)
+	fmt.Printf("Input length: %d characters, split into %d chunk(s)\n\n", len(text), len(chunks))
+
+	outFile, err := os.Create(*out)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+		os.Exit(1)
+	}
+	defer outFile.Close()
+
+	var totalBytes int64
+	for i, chunk := range chunks {
+		fmt.Printf("Synthesizing chunk %d/%d (%d chars)...\n", i+1, len(chunks), len(chunk))
+		n, err := tts.Synthesize(context.Background(), apiKey, tts.Request{
+			Input:          chunk,
+			Voice:          *voice,
+			ResponseFormat: tts.Format(*format),
+		}, outFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error synthesizing chunk %d: %v\n", i+1, err)
+			os.Exit(1)
+		}
+		totalBytes += n
+	}
+
+	cost := (float64(len(text)) / 1_000_000) * costPerMillionChars
+
+	fmt.Println()
+	fmt.Println("============================================================")
+	fmt.Println("Result")
+	fmt.Println("============================================================")
+	fmt.Printf("Saved to: %s (%d bytes, %d chunk(s) concatenated)\n", *out, totalBytes, len(chunks))
+	fmt.Printf("Estimated cost: $%.6f (%d characters @ $%.2f / 1M chars)\n", cost, len(text), costPerMillionChars)
+
+	fmt.Println()
+	fmt.Println("Note: naive byte concatenation works for streaming codecs like")
+	fmt.Println("mp3 but is not a general solution for every container format.")
+	fmt.Println("To validate the round trip, feed the output file to 08_whisper.go.")
+}
@@ -2,31 +2,24 @@ package main
 
 // Example 7: Whisper - Audio Transcription with Whisper Large-v3-Turbo
 // Transcribe audio files using fast, cost-effective speech recognition
+//
+// The multipart.Writer request plumbing and the text-only
+// TranscriptionResponse type now live in pkg/whisper, which covers the
+// full verbose_json schema (segments, word-level timestamps) and renders
+// SRT/WebVTT subtitles from it - see pkg/whisper.Transcribe and
+// TranscriptionResponse.SRT/.VTT.
 
 import (
-	"bytes"
 	"encoding/json"
+	"flag"
 	"fmt"
-	"io"
-	"mime/multipart"
-	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
-)
 
-// Response structure for Whisper API
-type TranscriptionResponse struct {
-	// Text is the complete transcription of the audio file
-	// Includes punctuation, capitalization, and formatting
-	// Supports 99+ languages with automatic detection
-	Text string `json:"text"`
-
-	// Duration is the audio length in seconds
-	// Used to calculate cost: (Duration / 3600) × $0.04 per hour
-	// Example: 60 seconds = 0.0167 hours = $0.00067
-	Duration float64 `json:"duration"`
-}
+	"github.com/patdeg/ai101/go/pkg/whisper"
+)
 
 // MAIN FUNCTION OVERVIEW:
 // =======================
@@ -49,139 +42,46 @@ type TranscriptionResponse struct {
 //   - Duration and cost information
 //
 func main() {
-	// Step 1: Get API key from environment variable
-	apiKey := os.Getenv("GROQ_API_KEY")
+	// Step 1: Parse flags and get API key from environment variable
+	audioFilePath := flag.String("file", "../gettysburg.mp3", "audio file to transcribe")
+	format := flag.String("format", "verbose_json", "output format: json, verbose_json, srt, vtt, or text")
+	flag.Parse()
 
-	// Verify API key exists
+	apiKey := os.Getenv("GROQ_API_KEY")
 	if apiKey == "" {
 		fmt.Println("Error: GROQ_API_KEY environment variable not set")
 		fmt.Println("Run: export GROQ_API_KEY='your_key_here'")
 		os.Exit(1)
 	}
 
-	// Step 2: Prepare the audio file path
-	audioFilePath := "../gettysburg.mp3"
-
-	// Check if file exists
-	if _, err := os.Stat(audioFilePath); os.IsNotExist(err) {
-		fmt.Printf("Error: Audio file not found at %s\n", audioFilePath)
-		os.Exit(1)
-	}
-
-	// Open the audio file
-	file, err := os.Open(audioFilePath)
-	if err != nil {
-		fmt.Printf("Error opening audio file: %v\n", err)
-		os.Exit(1)
-	}
-	defer file.Close()
-
-	// Get file info for size
-	fileInfo, err := file.Stat()
-	if err != nil {
-		fmt.Printf("Error getting file info: %v\n", err)
+	// Step 2: Check the audio file exists
+	if _, err := os.Stat(*audioFilePath); os.IsNotExist(err) {
+		fmt.Printf("Error: Audio file not found at %s\n", *audioFilePath)
 		os.Exit(1)
 	}
 
 	fmt.Println("============================================================")
 	fmt.Println("Transcribing Audio with Whisper")
 	fmt.Println("============================================================")
-	fmt.Println("Model: whisper-large-v3-turbo")
-	fmt.Printf("File: %s\n", audioFilePath)
-	fmt.Printf("File size: %d bytes\n", fileInfo.Size())
+	fmt.Println("Model:", whisper.DefaultModel)
+	fmt.Printf("File: %s\n", *audioFilePath)
+	fmt.Printf("Format: %s\n", *format)
 	fmt.Println()
 
-	// Step 3: Create multipart form data
-	// Create a buffer to write the multipart data
-	var requestBody bytes.Buffer
-	writer := multipart.NewWriter(&requestBody)
-
-	// Add the audio file to the form
-	part, err := writer.CreateFormFile("file", filepath.Base(audioFilePath))
-	if err != nil {
-		fmt.Printf("Error creating form file: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Copy the file content to the form part
-	_, err = io.Copy(part, file)
-	if err != nil {
-		fmt.Printf("Error copying file content: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Add the model parameter
-	err = writer.WriteField("model", "whisper-large-v3-turbo")
-	if err != nil {
-		fmt.Printf("Error writing model field: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Add response format for duration info
-	err = writer.WriteField("response_format", "verbose_json")
-	if err != nil {
-		fmt.Printf("Error writing response_format field: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Close the multipart writer to finalize the body
-	err = writer.Close()
-	if err != nil {
-		fmt.Printf("Error closing multipart writer: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Step 4: Create HTTP request
-	req, err := http.NewRequest(
-		"POST",
-		"https://api.groq.com/openai/v1/audio/transcriptions",
-		&requestBody,
-	)
-
-	if err != nil {
-		fmt.Printf("Error creating request: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Step 5: Set HTTP headers
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-
-	// Step 6: Send the HTTP request with timing
+	// Step 3: Send the transcription request with timing
 	startTime := time.Now()
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-
+	response, err := whisper.Transcribe(apiKey, whisper.Request{
+		AudioPath:              *audioFilePath,
+		Format:                 whisper.Format(*format),
+		TimestampGranularities: []whisper.Granularity{whisper.GranularitySegment, whisper.GranularityWord},
+	})
 	if err != nil {
-		fmt.Printf("Error sending request: %v\n", err)
+		fmt.Printf("Error transcribing audio: %v\n", err)
 		os.Exit(1)
 	}
-
-	defer resp.Body.Close()
-
 	latency := time.Since(startTime).Milliseconds()
 
-	// Step 7: Read the response body
-	body, err := io.ReadAll(resp.Body)
-
-	if err != nil {
-		fmt.Printf("Error reading response: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Step 8: Parse the JSON response
-	var response TranscriptionResponse
-
-	err = json.Unmarshal(body, &response)
-
-	if err != nil {
-		fmt.Printf("Error parsing JSON: %v\n", err)
-		fmt.Printf("Raw response: %s\n", string(body))
-		os.Exit(1)
-	}
-
-	// Step 9: Display the transcription result
+	// Step 4: Display the transcription result
 	fmt.Println("Transcription Result:")
 	fmt.Println("============================================================")
 	fmt.Println(response.Text)
@@ -196,12 +96,38 @@ func main() {
 	fmt.Println("============================================================")
 	fmt.Printf("API Latency:    %dms\n", latency)
 	fmt.Printf("Audio Duration: %.2fs\n", response.Duration)
+	fmt.Printf("Segments:       %d\n", len(response.Segments))
+	fmt.Printf("Words:          %d\n", len(response.Words))
 	fmt.Printf("Cost:           $%.6f\n", cost)
 	fmt.Println("============================================================")
 	fmt.Println()
-	fmt.Println("Full API Response:")
-	jsonBytes, _ := json.MarshalIndent(response, "", "  ")
-	fmt.Println(string(jsonBytes))
+
+	// Step 5: Write subtitles to disk for srt/vtt, otherwise dump the
+	// full parsed response so segments/words are visible too.
+	switch whisper.Format(*format) {
+	case whisper.FormatSRT, whisper.FormatVTT:
+		outPath := subtitlePath(*audioFilePath, whisper.Format(*format))
+		content := response.SRT()
+		if whisper.Format(*format) == whisper.FormatVTT {
+			content = response.VTT()
+		}
+		if err := os.WriteFile(outPath, []byte(content), 0644); err != nil {
+			fmt.Printf("Error saving subtitles: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Subtitles saved to: %s\n", outPath)
+	default:
+		fmt.Println("Full API Response:")
+		jsonBytes, _ := json.MarshalIndent(response, "", "  ")
+		fmt.Println(string(jsonBytes))
+	}
+}
+
+// subtitlePath swaps audioPath's extension for format's, so
+// gettysburg.mp3 with -format srt saves to gettysburg.srt.
+func subtitlePath(audioPath string, format whisper.Format) string {
+	base := strings.TrimSuffix(filepath.Base(audioPath), filepath.Ext(audioPath))
+	return base + "." + string(format)
 }
 
 // Model: whisper-large-v3-turbo
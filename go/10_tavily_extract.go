@@ -17,35 +17,31 @@ This example:
 - Uses "advanced" extraction depth
 - Includes images from the article
 - Saves clean markdown output to file
+
+Note: the request/response types and HTTP plumbing now live in
+pkg/tavily instead of this file's own structs and a bare http.Client.Do.
+For extracting many URLs at once without buffering every page in memory,
+see pkg/tavily.Client.BatchExtract.
+
+Before saving, pkg/webclip absolutizes every image and link URL in the
+extracted markdown against the article URL (relative and protocol-relative
+links would otherwise be meaningless once saved to a local file), fetches
+the article's OpenGraph metadata, and prepends a YAML front-matter block -
+turning the raw extraction into a real read-later clip.
 */
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"net/url"
 	"os"
-)
-
-// TavilyExtractRequest represents the API request structure
-type TavilyExtractRequest struct {
-	URLs          []string `json:"urls"`
-	IncludeImages bool     `json:"include_images"`
-	ExtractDepth  string   `json:"extract_depth"`
-}
+	"time"
 
-// TavilyExtractResponse represents the API response structure
-type TavilyExtractResponse struct {
-	Results []struct {
-		URL        string   `json:"url"`
-		RawContent string   `json:"raw_content"`
-		Images     []string `json:"images"`
-	} `json:"results"`
-	FailedResults []string `json:"failed_results"`
-	ResponseTime  float64  `json:"response_time"`
-	Error         string   `json:"error,omitempty"`
-}
+	"github.com/patdeg/ai101/go/pkg/httpx"
+	"github.com/patdeg/ai101/go/pkg/tavily"
+	"github.com/patdeg/ai101/go/pkg/webclip"
+)
 
 func main() {
 	// Check for API key
@@ -59,12 +55,6 @@ func main() {
 
 	articleURL := "https://medium.com/@pdeglon/california-ai-rules-explained-in-everyday-english-fea55637cb96"
 
-	request := TavilyExtractRequest{
-		URLs:          []string{articleURL},
-		IncludeImages: true,
-		ExtractDepth:  "advanced",
-	}
-
 	fmt.Println("===========================================")
 	fmt.Println("Tavily Extract API - Medium Article")
 	fmt.Println("===========================================\n")
@@ -72,48 +62,19 @@ func main() {
 	fmt.Println("Options: extract_depth=advanced, include_images=true\n")
 	fmt.Println("Sending request to Tavily...\n")
 
-	// Make API request
-	jsonData, err := json.Marshal(request)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error marshaling request: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Create request with Authorization header
-	req, err := http.NewRequest("POST", "https://api.tavily.com/extract", bytes.NewBuffer(jsonData))
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error creating request: %v\n", err)
-		os.Exit(1)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", apiKey))
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	client, err := tavily.New(apiKey)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error making request: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error building Tavily client: %v\n", err)
 		os.Exit(1)
 	}
-	defer resp.Body.Close()
 
-	// Read response
-	body, err := io.ReadAll(resp.Body)
+	result, err := client.Extract(context.Background(), tavily.ExtractRequest{
+		URLs:          []string{articleURL},
+		IncludeImages: true,
+		ExtractDepth:  "advanced",
+	})
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading response: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Parse response
-	var result TavilyExtractResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		fmt.Fprintf(os.Stderr, "Error parsing response: %v\n", err)
-		fmt.Fprintf(os.Stderr, "Raw response: %s\n", string(body))
-		os.Exit(1)
-	}
-
-	// Check for errors
-	if result.Error != "" {
-		fmt.Fprintf(os.Stderr, "API Error: %s\n", result.Error)
+		fmt.Fprintf(os.Stderr, "Error extracting content: %v\n", err)
 		os.Exit(1)
 	}
 
@@ -133,8 +94,17 @@ func main() {
 	fmt.Printf("Successful: %d\n", resultCount)
 	fmt.Printf("Failed: %d\n\n", failedCount)
 
+	// Absolutize every image/link URL against the article URL, since
+	// relative and protocol-relative links only made sense on the original
+	// page - saved to a local file, they'd otherwise point nowhere.
+	base, err := url.Parse(articleURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing article URL: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Extract content
-	content := result.Results[0].RawContent
+	content := webclip.AbsolutizeMarkdown(result.Results[0].RawContent, base)
 	contentLength := len(content)
 
 	fmt.Println("========================================")
@@ -151,7 +121,7 @@ func main() {
 	}
 
 	// Extract images
-	images := result.Results[0].Images
+	images := webclip.AbsolutizeURLs(result.Results[0].Images, base)
 	imageCount := len(images)
 
 	fmt.Println("========================================")
@@ -172,9 +142,24 @@ func main() {
 	fmt.Printf("Content Size: %d characters\n", contentLength)
 	fmt.Printf("Images Extracted: %d\n\n", imageCount)
 
+	// Fetch the article's OpenGraph metadata for the front-matter header.
+	// This is a second, lightweight GET of the same page Tavily already
+	// extracted - Tavily's response doesn't carry og:title/og:description.
+	htmlClient, err := httpx.DefaultClient()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error building HTTP client: %v\n", err)
+		os.Exit(1)
+	}
+	metadata, err := webclip.FetchMetadata(htmlClient, articleURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to fetch OpenGraph metadata: %v\n", err)
+	}
+
+	frontMatter := webclip.FrontMatter(metadata, articleURL, time.Now(), images)
+
 	// Save full content to file
 	outputFile := "extracted_content.md"
-	err = os.WriteFile(outputFile, []byte(content), 0644)
+	err = os.WriteFile(outputFile, []byte(frontMatter+content), 0644)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error saving file: %v\n", err)
 		os.Exit(1)
@@ -6,10 +6,19 @@ Example 10: Tool Use (Function Calling) - AI Agents with Groq + Tavily
 Demonstrates:
 - Groq function calling with llama-4-scout
 - Tavily Search and Extract as tools
+- Telegram Bot API as tools (send a photo, fetch an uploaded file)
 - Multi-step agent workflow
 
 Note: Uses Go standard library only
 For production, consider using official SDKs
+
+Telegram tools are optional: they only need TELEGRAM_BOT_TOKEN set if the
+model actually decides to call telegram_send_photo or telegram_get_file.
+
+Usage:
+    go run 10_tool_use.go            # compact tool call logging (no responses)
+    go run 10_tool_use.go --trace    # pretty-print tool arguments/responses
+                                      # as indented JSON for easier debugging
 */
 
 import (
@@ -19,6 +28,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 )
 
 // Tool function definitions
@@ -83,12 +93,113 @@ func tavilyExtract(url string) (map[string]interface{}, error) {
 	return result, nil
 }
 
+func telegramSendPhoto(chatID, photoURL string) (map[string]interface{}, error) {
+	fmt.Printf("[Tool] Executing telegram_send_photo to chat %s: %s\n", chatID, photoURL)
+
+	token := os.Getenv("TELEGRAM_BOT_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("TELEGRAM_BOT_TOKEN environment variable not set")
+	}
+
+	requestBody, _ := json.Marshal(map[string]interface{}{
+		"chat_id": chatID,
+		"photo":   photoURL,
+	})
+
+	url := fmt.Sprintf("https://api.telegram.org/bot%s/sendPhoto", token)
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	var result map[string]interface{}
+	json.Unmarshal(body, &result)
+	return result, nil
+}
+
+func telegramGetFile(fileID string) (map[string]interface{}, error) {
+	fmt.Printf("[Tool] Executing telegram_get_file for file_id: %s\n", fileID)
+
+	token := os.Getenv("TELEGRAM_BOT_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("TELEGRAM_BOT_TOKEN environment variable not set")
+	}
+
+	url := fmt.Sprintf("https://api.telegram.org/bot%s/getFile?file_id=%s", token, fileID)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	var result map[string]interface{}
+	json.Unmarshal(body, &result)
+	return result, nil
+}
+
+// stringArg reads arguments[key] as a string, also accepting a JSON
+// number (decoded by encoding/json as float64) since models commonly
+// return numeric-looking fields like Telegram's chat_id as a bare number
+// rather than a string.
+func stringArg(arguments map[string]interface{}, key string) (string, error) {
+	switch v := arguments[key].(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("argument %q is missing or not a string: %v", key, arguments[key])
+	}
+}
+
 func executeTool(functionName string, arguments map[string]interface{}) (map[string]interface{}, error) {
 	switch functionName {
 	case "tavily_search":
-		return tavilySearch(arguments["query"].(string))
+		query, err := stringArg(arguments, "query")
+		if err != nil {
+			return nil, err
+		}
+		return tavilySearch(query)
 	case "tavily_extract":
-		return tavilyExtract(arguments["url"].(string))
+		url, err := stringArg(arguments, "url")
+		if err != nil {
+			return nil, err
+		}
+		return tavilyExtract(url)
+	case "telegram_send_photo":
+		chatID, err := stringArg(arguments, "chat_id")
+		if err != nil {
+			return nil, err
+		}
+		photoURL, err := stringArg(arguments, "photo_url")
+		if err != nil {
+			return nil, err
+		}
+		return telegramSendPhoto(chatID, photoURL)
+	case "telegram_get_file":
+		fileID, err := stringArg(arguments, "file_id")
+		if err != nil {
+			return nil, err
+		}
+		return telegramGetFile(fileID)
 	default:
 		return nil, fmt.Errorf("unknown function: %s", functionName)
 	}
@@ -129,7 +240,28 @@ func callGroq(messages []interface{}, tools []interface{}) (map[string]interface
 	return result, nil
 }
 
+// trace is set by the --trace flag; when on, tool arguments and responses
+// are pretty-printed with json.MarshalIndent instead of the compact form,
+// to make a multi-step agent's tool traffic easier to read while debugging.
+var trace bool
+
+// prettyJSON formats v as indented JSON for --trace output, falling back
+// to fmt's default formatting if it isn't JSON-marshalable.
+func prettyJSON(v interface{}) string {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(out)
+}
+
 func main() {
+	for _, arg := range os.Args[1:] {
+		if arg == "--trace" {
+			trace = true
+		}
+	}
+
 	// Check for API keys
 	if os.Getenv("GROQ_API_KEY") == "" || os.Getenv("TAVILY_API_KEY") == "" {
 		fmt.Fprintln(os.Stderr, "Error: Both GROQ_API_KEY and TAVILY_API_KEY must be set")
@@ -183,6 +315,44 @@ func main() {
 				},
 			},
 		},
+		map[string]interface{}{
+			"type": "function",
+			"function": map[string]interface{}{
+				"name":        "telegram_send_photo",
+				"description": "Send a photo to a Telegram chat via the bot API",
+				"parameters": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"chat_id": map[string]interface{}{
+							"type":        "string",
+							"description": "The Telegram chat ID to send the photo to",
+						},
+						"photo_url": map[string]interface{}{
+							"type":        "string",
+							"description": "A URL pointing to the photo to send",
+						},
+					},
+					"required": []string{"chat_id", "photo_url"},
+				},
+			},
+		},
+		map[string]interface{}{
+			"type": "function",
+			"function": map[string]interface{}{
+				"name":        "telegram_get_file",
+				"description": "Fetch metadata (including the download path) for a file previously uploaded to Telegram, by its file_id",
+				"parameters": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"file_id": map[string]interface{}{
+							"type":        "string",
+							"description": "The Telegram file_id to look up",
+						},
+					},
+					"required": []string{"file_id"},
+				},
+			},
+		},
 	}
 
 	// Step 1: Initial request
@@ -222,18 +392,26 @@ func main() {
 		functionName := function["name"].(string)
 		argumentsStr := function["arguments"].(string)
 
-		fmt.Printf("Function: %s\n", functionName)
-		fmt.Printf("Arguments: %s\n\n", argumentsStr)
-
 		var arguments map[string]interface{}
 		json.Unmarshal([]byte(argumentsStr), &arguments)
 
+		fmt.Printf("Function: %s\n", functionName)
+		if trace {
+			fmt.Printf("Arguments:\n%s\n\n", prettyJSON(arguments))
+		} else {
+			fmt.Printf("Arguments: %s\n\n", argumentsStr)
+		}
+
 		result, err := executeTool(functionName, arguments)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error executing tool: %v\n", err)
 			continue
 		}
 
+		if trace {
+			fmt.Printf("Response:\n%s\n\n", prettyJSON(result))
+		}
+
 		resultJSON, _ := json.Marshal(result)
 
 		toolMessages = append(toolMessages, map[string]interface{}{
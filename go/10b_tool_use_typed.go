@@ -0,0 +1,113 @@
+package main
+
+// Example 10b: Typed Tool Calling with pkg/llm
+//
+// The sibling of 10_tool_use.go: that example builds a real multi-step
+// agent (Tavily search/extract, Telegram) against Groq's raw JSON shape
+// with untyped map[string]interface{} tools and messages, on purpose -
+// useful once, for seeing the wire format. This example shows the other
+// side: pkg/llm.ChatRequest's typed Tools/ToolChoice/Functions fields and
+// ChatResponse.ToolCalls, which OpenAIProvider (so Groq, Demeterics,
+// OpenAI, and Mistral) now understands.
+//
+// It deliberately builds its request with the legacy Functions field
+// instead of Tools, to demonstrate llm.NormalizeTools' auto-wrap shim:
+// OpenAIProvider.Chat calls NormalizeTools internally, so a caller still
+// using Functions gets real tool calls back exactly as a Tools caller
+// would.
+//
+// The loop itself is the standard two turns:
+//  1. Send the question plus tool definitions; the model replies with
+//     ToolCalls instead of an answer.
+//  2. Run the requested tool locally, append the assistant's tool-call
+//     message and a "tool" role message (with ToolCallID) reporting the
+//     result, and ask again for the final answer.
+//
+// Run with: GROQ_API_KEY='...' go run 10b_tool_use_typed.go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/llm"
+)
+
+// getWeather is the one tool this example exposes - a stand-in with a
+// fixed answer, since the point is the tool-calling loop, not a real
+// weather API.
+func getWeather(city string) string {
+	return fmt.Sprintf("%s: 18C, light rain", city)
+}
+
+var weatherFunction = llm.Function{
+	Name:        "get_weather",
+	Description: "Get the current weather for a city",
+	Parameters: map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"city": map[string]interface{}{
+				"type":        "string",
+				"description": "City name, e.g. Zurich",
+			},
+		},
+		"required": []string{"city"},
+	},
+}
+
+func main() {
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY not set")
+		os.Exit(1)
+	}
+	provider := llm.NewGroqProvider(apiKey)
+	ctx := context.Background()
+
+	req := llm.ChatRequest{
+		Messages:   []llm.Message{{Role: "user", Content: "What's the weather like in Zurich?"}},
+		Functions:  []llm.Function{weatherFunction}, // legacy surface; NormalizeTools wraps it into Tools
+		ToolChoice: "auto",
+		MaxTokens:  300,
+	}
+
+	fmt.Println("Turn 1: asking the model...")
+	resp, err := provider.Chat(ctx, req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	if len(resp.ToolCalls) == 0 {
+		fmt.Println("Answer (no tool call needed):", resp.Text)
+		return
+	}
+
+	call := resp.ToolCalls[0]
+	fmt.Printf("Model requested tool %q with arguments %s\n", call.Function.Name, call.Function.Arguments)
+
+	var args struct {
+		City string `json:"city"`
+	}
+	if err := json.Unmarshal([]byte(call.Function.Arguments), &args); err != nil {
+		fmt.Fprintln(os.Stderr, "Error parsing tool arguments:", err)
+		os.Exit(1)
+	}
+	result := getWeather(args.City)
+	fmt.Println("Tool result:", result)
+
+	req.Messages = append(req.Messages,
+		llm.Message{Role: "assistant", ToolCalls: resp.ToolCalls},
+		llm.Message{Role: "tool", Content: result, ToolCallID: call.ID},
+	)
+
+	fmt.Println("\nTurn 2: feeding the tool result back...")
+	final, err := provider.Chat(ctx, req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Final answer:", final.Text)
+}
@@ -15,6 +15,12 @@ What you'll learn:
 Prerequisites:
   - GROQ_API_KEY environment variable set
 
+Flags:
+  -include   comma-separated domains to restrict search to (e.g. arxiv.org,openai.com)
+  -exclude   comma-separated domains to exclude from search
+  -country   two-letter country code to bias search locality (e.g. us)
+  -moderate  run the query through a LlamaGuard pre-flight check first
+
 Expected output:
   - Final answer content
   - Optional reasoning and executed tool info (if search was used)
@@ -24,11 +30,16 @@ Exercises: exercises/11_web_search.md
 
 import (
     "bytes"
+    "context"
     "encoding/json"
+    "flag"
     "fmt"
     "io"
     "net/http"
     "os"
+    "strings"
+
+    "github.com/patdeg/ai101/go/pkg/moderation"
 )
 
 // Chat structures
@@ -37,11 +48,60 @@ type ChatMessage struct {
     Content string `json:"content"`
 }
 
+// SearchSettings maps to groq/compound-mini's nested search_settings
+// object: include/exclude narrow which domains the model's web search
+// is allowed to consult, Country biases results toward a locality, and
+// MaxResults caps how many results the search tool returns. Every field
+// is omitempty, so a zero-value SearchSettings serializes to an empty
+// object; leaving the request's pointer nil omits search_settings
+// entirely and the request falls back to the model's default search
+// behavior.
+type SearchSettings struct {
+    IncludeDomains []string `json:"include_domains,omitempty"`
+    ExcludeDomains []string `json:"exclude_domains,omitempty"`
+    Country        string   `json:"country,omitempty"`
+    MaxResults     int      `json:"max_results,omitempty"`
+}
+
+// empty reports whether s would serialize to nothing useful, so callers
+// can leave SearchSettings nil instead of sending an empty object.
+func (s SearchSettings) empty() bool {
+    return len(s.IncludeDomains) == 0 && len(s.ExcludeDomains) == 0 && s.Country == "" && s.MaxResults == 0
+}
+
 type ChatRequest struct {
-    Model       string        `json:"model"`
-    Messages    []ChatMessage `json:"messages"`
-    Temperature float64       `json:"temperature"`
-    MaxTokens   int           `json:"max_tokens"`
+    Model          string          `json:"model"`
+    Messages       []ChatMessage   `json:"messages"`
+    Temperature    float64         `json:"temperature"`
+    MaxTokens      int             `json:"max_tokens"`
+    SearchSettings *SearchSettings `json:"search_settings,omitempty"`
+}
+
+// splitCSV splits a comma-separated flag value into trimmed, non-empty
+// entries, returning nil for an empty input.
+func splitCSV(s string) []string {
+    if s == "" {
+        return nil
+    }
+    var out []string
+    for _, part := range strings.Split(s, ",") {
+        if part = strings.TrimSpace(part); part != "" {
+            out = append(out, part)
+        }
+    }
+    return out
+}
+
+// printExecutedTools pretty-prints the executed_tools field the API
+// returns when the model actually performed a search, so users can see
+// which URLs were consulted (or that domain filters excluded them all).
+func printExecutedTools(executedTools interface{}) {
+    if executedTools == nil {
+        fmt.Println("(no executed tool info)")
+        return
+    }
+    pretty, _ := json.MarshalIndent(executedTools, "", "  ")
+    fmt.Println(string(pretty))
 }
 
 // Partial response struct to extract what we need
@@ -60,6 +120,12 @@ type ChatResponse struct {
 }
 
 func main() {
+    include := flag.String("include", "", "comma-separated domains to restrict search to (e.g. arxiv.org,openai.com)")
+    exclude := flag.String("exclude", "", "comma-separated domains to exclude from search")
+    country := flag.String("country", "", "two-letter country code to bias search locality (e.g. us)")
+    moderate := flag.Bool("moderate", false, "run the query through a LlamaGuard pre-flight check before searching")
+    flag.Parse()
+
     apiKey := os.Getenv("GROQ_API_KEY")
     if apiKey == "" {
         fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY not set")
@@ -67,15 +133,37 @@ func main() {
         os.Exit(1)
     }
 
+    query := "What were the top 3 AI model releases last week? Include links and 1-sentence summaries."
+
+    if *moderate {
+        moderator := moderation.NewLlamaGuardModerator("https://api.groq.com/openai/v1", apiKey)
+        verdict, err := moderator.Check(context.Background(), "user", query)
+        if err != nil {
+            fmt.Fprintf(os.Stderr, "Warning: moderation pre-flight check failed, continuing without it: %v\n", err)
+        } else if verdict.Flagged {
+            fmt.Fprintf(os.Stderr, "Blocked by moderation pre-flight check: %v\n", verdict.CategoryDescriptions())
+            os.Exit(1)
+        }
+    }
+
+    settings := SearchSettings{
+        IncludeDomains: splitCSV(*include),
+        ExcludeDomains: splitCSV(*exclude),
+        Country:        *country,
+    }
+
     reqBody := ChatRequest{
         Model: "groq/compound-mini",
         Messages: []ChatMessage{
             {Role: "system", Content: "You are a helpful research assistant. Provide concise answers with links. Use search when needed."},
-            {Role: "user", Content: "What were the top 3 AI model releases last week? Include links and 1-sentence summaries."},
+            {Role: "user", Content: query},
         },
         Temperature: 0.3,
         MaxTokens:   600,
     }
+    if !settings.empty() {
+        reqBody.SearchSettings = &settings
+    }
 
     b, err := json.Marshal(reqBody)
     if err != nil {
@@ -139,19 +227,14 @@ func main() {
     fmt.Println("========================================")
     fmt.Println("Executed Tools (if any)")
     fmt.Println("========================================")
-    if msg.ExecutedTools != nil {
-        pretty, _ := json.MarshalIndent(msg.ExecutedTools, "", "  ")
-        fmt.Println(string(pretty))
-    } else {
-        fmt.Println("(no executed tool info)")
-    }
+    printExecutedTools(msg.ExecutedTools)
     fmt.Println()
 
     // Exercises:
     // 1) Change model to "groq/compound" for a larger model.
     // 2) Ask a boolean-style query: "(OpenAI OR Meta) AND release notes last 7 days".
-    // 3) If your deployment supports web-search parameters (include/exclude domains, country),
-    //    consult docs and add them in the payload.
+    // 3) Run: go run 11_web_search.go -include=arxiv.org,openai.com -country=us
+    // 4) See 11b_web_search_domains.go to diff citations with and without domain filters.
 
     fmt.Println("Exercises: exercises/11_web_search.md")
 }
@@ -0,0 +1,200 @@
+package main
+
+/*
+Example 11b: Domain-Filtered Search Diff
+
+Runs the same query through groq/compound-mini twice - once unrestricted,
+once with an include_domains filter - and diffs the URLs each run's
+executed_tools actually consulted, so users can see what a domain filter
+changes instead of just taking it on faith.
+
+Prerequisites:
+  - GROQ_API_KEY environment variable set
+
+Run:
+
+	go run 11b_web_search_domains.go
+*/
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "io"
+    "net/http"
+    "os"
+)
+
+type domainChatMessage struct {
+    Role    string `json:"role"`
+    Content string `json:"content"`
+}
+
+type domainSearchSettings struct {
+    IncludeDomains []string `json:"include_domains,omitempty"`
+}
+
+type domainChatRequest struct {
+    Model          string                `json:"model"`
+    Messages       []domainChatMessage   `json:"messages"`
+    Temperature    float64               `json:"temperature"`
+    MaxTokens      int                   `json:"max_tokens"`
+    SearchSettings *domainSearchSettings `json:"search_settings,omitempty"`
+}
+
+type domainChoiceMessage struct {
+    Content       string      `json:"content"`
+    ExecutedTools interface{} `json:"executed_tools,omitempty"`
+}
+
+type domainChatResponse struct {
+    Choices []struct {
+        Message domainChoiceMessage `json:"message"`
+    } `json:"choices"`
+}
+
+// runQuery posts query to groq/compound-mini with the given
+// SearchSettings (nil for unrestricted search) and returns the response
+// message.
+func runQuery(apiKey, query string, settings *domainSearchSettings) (*domainChoiceMessage, error) {
+    reqBody := domainChatRequest{
+        Model: "groq/compound-mini",
+        Messages: []domainChatMessage{
+            {Role: "system", Content: "You are a helpful research assistant. Use search when needed."},
+            {Role: "user", Content: query},
+        },
+        Temperature:    0.3,
+        MaxTokens:      600,
+        SearchSettings: settings,
+    }
+
+    b, err := json.Marshal(reqBody)
+    if err != nil {
+        return nil, fmt.Errorf("marshal error: %w", err)
+    }
+
+    req, err := http.NewRequest("POST", "https://api.groq.com/openai/v1/chat/completions", bytes.NewBuffer(b))
+    if err != nil {
+        return nil, fmt.Errorf("request error: %w", err)
+    }
+    req.Header.Set("Authorization", "Bearer "+apiKey)
+    req.Header.Set("Content-Type", "application/json")
+
+    resp, err := http.DefaultClient.Do(req)
+    if err != nil {
+        return nil, fmt.Errorf("http error: %w", err)
+    }
+    defer resp.Body.Close()
+
+    raw, err := io.ReadAll(resp.Body)
+    if err != nil {
+        return nil, fmt.Errorf("read error: %w", err)
+    }
+
+    var out domainChatResponse
+    if err := json.Unmarshal(raw, &out); err != nil {
+        return nil, fmt.Errorf("parse error: %w (raw: %s)", err, raw)
+    }
+    if len(out.Choices) == 0 {
+        return &domainChoiceMessage{}, nil
+    }
+    return &out.Choices[0].Message, nil
+}
+
+// citedURLs walks an executed_tools value looking for any "url" string
+// fields, regardless of how deeply they're nested - the API doesn't
+// document a fixed schema for this field, so we don't assume one.
+func citedURLs(v interface{}) []string {
+    var urls []string
+    switch val := v.(type) {
+    case map[string]interface{}:
+        for key, nested := range val {
+            if key == "url" {
+                if s, ok := nested.(string); ok {
+                    urls = append(urls, s)
+                    continue
+                }
+            }
+            urls = append(urls, citedURLs(nested)...)
+        }
+    case []interface{}:
+        for _, item := range val {
+            urls = append(urls, citedURLs(item)...)
+        }
+    }
+    return urls
+}
+
+// diffURLs reports which URLs appear only in a or only in b.
+func diffURLs(a, b []string) (onlyA, onlyB []string) {
+    inA := map[string]bool{}
+    inB := map[string]bool{}
+    for _, u := range a {
+        inA[u] = true
+    }
+    for _, u := range b {
+        inB[u] = true
+    }
+    for u := range inA {
+        if !inB[u] {
+            onlyA = append(onlyA, u)
+        }
+    }
+    for u := range inB {
+        if !inA[u] {
+            onlyB = append(onlyB, u)
+        }
+    }
+    return onlyA, onlyB
+}
+
+func main() {
+    apiKey := os.Getenv("GROQ_API_KEY")
+    if apiKey == "" {
+        fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY not set")
+        os.Exit(1)
+    }
+
+    query := "What are the latest advances in large language model reasoning?"
+
+    fmt.Println("========================================")
+    fmt.Println("Unrestricted search")
+    fmt.Println("========================================")
+    unrestricted, err := runQuery(apiKey, query, nil)
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+        os.Exit(1)
+    }
+    unrestrictedURLs := citedURLs(unrestricted.ExecutedTools)
+    for _, u := range unrestrictedURLs {
+        fmt.Println(" -", u)
+    }
+
+    fmt.Println()
+    fmt.Println("========================================")
+    fmt.Println("Filtered to arxiv.org only")
+    fmt.Println("========================================")
+    filtered, err := runQuery(apiKey, query, &domainSearchSettings{IncludeDomains: []string{"arxiv.org"}})
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+        os.Exit(1)
+    }
+    filteredURLs := citedURLs(filtered.ExecutedTools)
+    for _, u := range filteredURLs {
+        fmt.Println(" -", u)
+    }
+
+    fmt.Println()
+    fmt.Println("========================================")
+    fmt.Println("Diff")
+    fmt.Println("========================================")
+    onlyUnrestricted, onlyFiltered := diffURLs(unrestrictedURLs, filteredURLs)
+    fmt.Printf("Only in unrestricted run (%d):\n", len(onlyUnrestricted))
+    for _, u := range onlyUnrestricted {
+        fmt.Println(" -", u)
+    }
+    fmt.Printf("Only in filtered run (%d):\n", len(onlyFiltered))
+    for _, u := range onlyFiltered {
+        fmt.Println(" -", u)
+    }
+}
@@ -0,0 +1,138 @@
+package main
+
+/*
+Example 11c: Streaming Web Search
+
+The stream:true sibling of 11_web_search.go: reads groq/compound-mini's
+text/event-stream response via pkg/sse.Reader instead of io.ReadAll,
+printing answer tokens as they arrive and any executed_tools deltas as
+soon as the model reports them, so users can watch search calls happen
+live instead of only seeing them in the final response.
+
+Prerequisites:
+  - GROQ_API_KEY environment variable set
+
+Run:
+
+	go run 11c_stream_web_search.go
+*/
+
+import (
+    "bytes"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "net/http"
+    "os"
+
+    "github.com/patdeg/ai101/go/pkg/sse"
+)
+
+type searchStreamRequest struct {
+    Model       string               `json:"model"`
+    Messages    []searchStreamMessage `json:"messages"`
+    Temperature float64              `json:"temperature"`
+    MaxTokens   int                  `json:"max_tokens"`
+    Stream      bool                 `json:"stream"`
+}
+
+type searchStreamMessage struct {
+    Role    string `json:"role"`
+    Content string `json:"content"`
+}
+
+type searchStreamChunk struct {
+    Choices []struct {
+        Delta struct {
+            Content       string      `json:"content"`
+            ExecutedTools interface{} `json:"executed_tools,omitempty"`
+        } `json:"delta"`
+        FinishReason *string `json:"finish_reason"`
+    } `json:"choices"`
+    Error *struct {
+        Message string `json:"message"`
+    } `json:"error"`
+}
+
+func main() {
+    apiKey := os.Getenv("GROQ_API_KEY")
+    if apiKey == "" {
+        fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY not set")
+        os.Exit(1)
+    }
+
+    reqBody := searchStreamRequest{
+        Model: "groq/compound-mini",
+        Messages: []searchStreamMessage{
+            {Role: "system", Content: "You are a helpful research assistant. Provide concise answers with links. Use search when needed."},
+            {Role: "user", Content: "What were the top 3 AI model releases last week? Include links and 1-sentence summaries."},
+        },
+        Temperature: 0.3,
+        MaxTokens:   600,
+        Stream:      true,
+    }
+
+    b, err := json.Marshal(reqBody)
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "marshal error: %v\n", err)
+        os.Exit(1)
+    }
+
+    req, err := http.NewRequest("POST", "https://api.groq.com/openai/v1/chat/completions", bytes.NewBuffer(b))
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "request error: %v\n", err)
+        os.Exit(1)
+    }
+    req.Header.Set("Authorization", "Bearer "+apiKey)
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Accept", "text/event-stream")
+
+    resp, err := http.DefaultClient.Do(req)
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "http error: %v\n", err)
+        os.Exit(1)
+    }
+    defer resp.Body.Close()
+
+    fmt.Println("========================================")
+    fmt.Println("Final Answer (streaming)")
+    fmt.Println("========================================")
+
+    reader := sse.NewReader(resp.Body)
+    for {
+        event, err := reader.Next()
+        if errors.Is(err, sse.ErrStreamDone) {
+            break
+        }
+        if err != nil {
+            fmt.Fprintf(os.Stderr, "\nstream read error: %v\n", err)
+            os.Exit(1)
+        }
+
+        if event.Event == "error" {
+            fmt.Fprintf(os.Stderr, "\nmid-stream error event: %s\n", event.Data)
+            os.Exit(1)
+        }
+
+        var chunk searchStreamChunk
+        if err := json.Unmarshal([]byte(event.Data), &chunk); err != nil {
+            fmt.Fprintf(os.Stderr, "\nparse error: %v\n", err)
+            os.Exit(1)
+        }
+        if chunk.Error != nil {
+            fmt.Fprintf(os.Stderr, "\napi error: %s\n", chunk.Error.Message)
+            os.Exit(1)
+        }
+
+        for _, choice := range chunk.Choices {
+            if choice.Delta.Content != "" {
+                fmt.Print(choice.Delta.Content)
+            }
+            if choice.Delta.ExecutedTools != nil {
+                pretty, _ := json.MarshalIndent(choice.Delta.ExecutedTools, "", "  ")
+                fmt.Printf("\n[executed_tools update]\n%s\n", pretty)
+            }
+        }
+    }
+    fmt.Println()
+}
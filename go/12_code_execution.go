@@ -6,6 +6,8 @@ Example 12: Code Execution with openai/gpt-oss-20b
 What this demonstrates:
   - A model that can execute Python to solve tasks
   - Inspecting optional reasoning and executed tool information
+  - Falling back to a local sandbox (pkg/sandbox) when the hosted
+    code_interpreter tool is unavailable or disabled
 
 What you'll learn:
   - Designing prompts that trigger code execution
@@ -18,16 +20,35 @@ Expected output:
   - Final answer content
   - message.reasoning and executed_tools (if present)
 
+Note: this example keeps its own Req/Resp types and raw http.NewRequest
+call instead of going through pkg/completer/pkg/llm. Its whole point is
+Groq's "code_interpreter" tool and the resulting executed_tools field,
+which pkg/llm.ChatRequest/ChatResponse don't model - they're normalized
+across providers that don't share that concept. Routing this example
+through the generic Completer would mean losing executed_tools entirely.
+
+Set LOCAL_SANDBOX=1 to skip the hosted tool entirely and run the
+model's code locally instead, through pkg/sandbox: the first turn asks
+the model to wrap any code to run in <code lang="python">...</code>,
+this example executes each block it finds, and a follow-up turn feeds
+the captured stdout/stderr back for a final answer - an end-to-end loop
+that doesn't depend on Groq's code_interpreter tool being enabled.
+
 Exercises: exercises/12_code_execution.md
 */
 
 import (
     "bytes"
+    "context"
     "encoding/json"
     "fmt"
     "io"
     "net/http"
     "os"
+    "regexp"
+    "strings"
+
+    "github.com/patdeg/ai101/go/pkg/sandbox"
 )
 
 type Msg struct {
@@ -68,59 +89,18 @@ func main() {
         os.Exit(1)
     }
 
-    r := Req{
-        Model: "openai/gpt-oss-20b",
-        Messages: []Msg{
-            {Role: "system", Content: "You can execute Python to verify results. If code raises an exception, show it and provide a corrected version."},
-            {Role: "user", Content: "1) Compute the 2000th prime number using Python. 2) Execute Python: print(1/0) to demonstrate the exception, then show how to catch it without crashing."},
-        },
-        Tools: []struct{Type string `json:"type"`}{
-            {Type: "code_interpreter"},
-        },
-        ToolChoice:  "required",
-        Temperature: 0.2,
-        MaxTokens:   900,
-    }
-
-    b, err := json.Marshal(r)
-    if err != nil {
-        fmt.Fprintf(os.Stderr, "marshal error: %v\n", err)
-        os.Exit(1)
-    }
-
-    req, err := http.NewRequest("POST", "https://api.groq.com/openai/v1/chat/completions", bytes.NewBuffer(b))
-    if err != nil {
-        fmt.Fprintf(os.Stderr, "request error: %v\n", err)
-        os.Exit(1)
-    }
-    req.Header.Set("Authorization", "Bearer "+apiKey)
-    req.Header.Set("Content-Type", "application/json")
-
-    resp, err := http.DefaultClient.Do(req)
-    if err != nil {
-        fmt.Fprintf(os.Stderr, "http error: %v\n", err)
-        os.Exit(1)
+    var msg RespMessage
+    var err error
+    if os.Getenv("LOCAL_SANDBOX") == "1" {
+        msg, err = runWithLocalSandbox(apiKey)
+    } else {
+        msg, err = runWithHostedTool(apiKey)
     }
-    defer resp.Body.Close()
-
-    raw, err := io.ReadAll(resp.Body)
     if err != nil {
-        fmt.Fprintf(os.Stderr, "read error: %v\n", err)
+        fmt.Fprintf(os.Stderr, "Error: %v\n", err)
         os.Exit(1)
     }
 
-    var out Resp
-    if err := json.Unmarshal(raw, &out); err != nil {
-        fmt.Fprintf(os.Stderr, "parse error: %v\n", err)
-        fmt.Fprintf(os.Stderr, "raw: %s\n", string(raw))
-        os.Exit(1)
-    }
-
-    msg := RespMessage{}
-    if len(out.Choices) > 0 {
-        msg = out.Choices[0].Message
-    }
-
     fmt.Println("========================================")
     fmt.Println("Final Answer")
     fmt.Println("========================================")
@@ -159,3 +139,142 @@ func main() {
 
     fmt.Println("Exercises: exercises/12_code_execution.md")
 }
+
+// runWithHostedTool asks Groq's code_interpreter tool to run the code
+// itself, exactly as this example has always worked.
+func runWithHostedTool(apiKey string) (RespMessage, error) {
+    r := Req{
+        Model: "openai/gpt-oss-20b",
+        Messages: []Msg{
+            {Role: "system", Content: "You can execute Python to verify results. If code raises an exception, show it and provide a corrected version."},
+            {Role: "user", Content: "1) Compute the 2000th prime number using Python. 2) Execute Python: print(1/0) to demonstrate the exception, then show how to catch it without crashing."},
+        },
+        Tools: []struct{Type string `json:"type"`}{
+            {Type: "code_interpreter"},
+        },
+        ToolChoice:  "required",
+        Temperature: 0.2,
+        MaxTokens:   900,
+    }
+
+    return chatCompletion(apiKey, r)
+}
+
+// runWithLocalSandbox skips the hosted tool: it asks the model to wrap
+// any code it wants run in <code lang="...">...</code>, executes each
+// block through pkg/sandbox.FromEnv, and hands the output back in a
+// follow-up turn so the model can give a final answer informed by what
+// actually happened.
+func runWithLocalSandbox(apiKey string) (RespMessage, error) {
+    r := Req{
+        Model: "openai/gpt-oss-20b",
+        Messages: []Msg{
+            {Role: "system", Content: "You can't execute code yourself. To run Python, wrap it exactly as <code lang=\"python\">...</code> and stop - don't guess the output. You'll be given the real stdout/stderr in a follow-up turn to finish your answer."},
+            {Role: "user", Content: "1) Compute the 2000th prime number using Python. 2) Execute Python: print(1/0) to demonstrate the exception, then show how to catch it without crashing."},
+        },
+        Temperature: 0.2,
+        MaxTokens:   900,
+    }
+
+    first, err := chatCompletion(apiKey, r)
+    if err != nil {
+        return RespMessage{}, err
+    }
+
+    blocks := extractCodeBlocks(first.Content)
+    if len(blocks) == 0 {
+        return first, nil
+    }
+
+    sb, err := sandbox.FromEnv()
+    if err != nil {
+        return RespMessage{}, fmt.Errorf("failed to build sandbox: %w", err)
+    }
+
+    ctx := context.Background()
+    results := make([]sandbox.Result, 0, len(blocks))
+    var transcript strings.Builder
+    for i, block := range blocks {
+        result, err := sb.Run(ctx, block.Language, block.Code, sandbox.DefaultLimits)
+        if err != nil {
+            return RespMessage{}, fmt.Errorf("failed to run code block %d locally: %w", i+1, err)
+        }
+        results = append(results, result)
+        fmt.Fprintf(&transcript, "Block %d exit=%d\nstdout:\n%s\nstderr:\n%s\n\n", i+1, result.ExitCode, result.Stdout, result.Stderr)
+    }
+
+    r.Messages = append(r.Messages,
+        Msg{Role: "assistant", Content: first.Content},
+        Msg{Role: "user", Content: "Here is the real output of running your code locally:\n\n" + transcript.String() + "\nGive your final answer."},
+    )
+
+    final, err := chatCompletion(apiKey, r)
+    if err != nil {
+        return RespMessage{}, err
+    }
+
+    pretty, _ := json.Marshal(results)
+    final.ExecutedTools = json.RawMessage(pretty)
+    return final, nil
+}
+
+// codeBlock is one <code lang="...">...</code> block extracted from a
+// model's answer.
+type codeBlock struct {
+    Language string
+    Code     string
+}
+
+var codeBlockPattern = regexp.MustCompile(`(?is)<code(?:\s+lang="(\w+)")?\s*>(.*?)</code>`)
+
+// extractCodeBlocks finds every <code> block in content, defaulting to
+// "python" when a block has no lang attribute.
+func extractCodeBlocks(content string) []codeBlock {
+    matches := codeBlockPattern.FindAllStringSubmatch(content, -1)
+    blocks := make([]codeBlock, 0, len(matches))
+    for _, m := range matches {
+        language := m[1]
+        if language == "" {
+            language = "python"
+        }
+        blocks = append(blocks, codeBlock{Language: language, Code: strings.TrimSpace(m[2])})
+    }
+    return blocks
+}
+
+// chatCompletion sends one chat completion request and returns its
+// first choice's message.
+func chatCompletion(apiKey string, r Req) (RespMessage, error) {
+    b, err := json.Marshal(r)
+    if err != nil {
+        return RespMessage{}, fmt.Errorf("marshal error: %w", err)
+    }
+
+    req, err := http.NewRequest("POST", "https://api.groq.com/openai/v1/chat/completions", bytes.NewBuffer(b))
+    if err != nil {
+        return RespMessage{}, fmt.Errorf("request error: %w", err)
+    }
+    req.Header.Set("Authorization", "Bearer "+apiKey)
+    req.Header.Set("Content-Type", "application/json")
+
+    resp, err := http.DefaultClient.Do(req)
+    if err != nil {
+        return RespMessage{}, fmt.Errorf("http error: %w", err)
+    }
+    defer resp.Body.Close()
+
+    raw, err := io.ReadAll(resp.Body)
+    if err != nil {
+        return RespMessage{}, fmt.Errorf("read error: %w", err)
+    }
+
+    var out Resp
+    if err := json.Unmarshal(raw, &out); err != nil {
+        return RespMessage{}, fmt.Errorf("parse error: %w (raw: %s)", err, string(raw))
+    }
+
+    if len(out.Choices) == 0 {
+        return RespMessage{}, nil
+    }
+    return out.Choices[0].Message, nil
+}
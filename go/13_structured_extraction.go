@@ -0,0 +1,54 @@
+package main
+
+// Example 13: Structured Extraction with pkg/structured
+//
+// Demonstrates extracting a typed, validated Go struct from freeform
+// text via pkg/structured.Extract, instead of hand-writing a one-off
+// "reply with JSON shaped like ..." prompt and parsing it ad hoc.
+//
+// Person's jsonschema tags become the JSON Schema sent to the model
+// (see pkg/structured/schema.go); its validate tags are checked against
+// the model's reply (see pkg/structured/validate.go), and Extract
+// re-prompts with the validation error on failure.
+//
+// Run with: GROQ_API_KEY='...' go run 13_structured_extraction.go
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/llm"
+	"github.com/patdeg/ai101/go/pkg/structured"
+)
+
+// Person is the shape pkg/structured.Extract fills in from freeform text.
+type Person struct {
+	Name       string `json:"name" jsonschema:"description=The person's full name,required" validate:"required"`
+	Age        int    `json:"age" jsonschema:"description=The person's age in years,required" validate:"required,min=0,max=150"`
+	Occupation string `json:"occupation" jsonschema:"description=The person's job or profession,required" validate:"required"`
+}
+
+func main() {
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY not set")
+		os.Exit(1)
+	}
+	provider := llm.NewGroqProvider(apiKey)
+
+	text := "Maria Santos has spent the last eleven years teaching high school chemistry in Porto, and just turned 37."
+
+	fmt.Println("Input text:", text)
+	fmt.Println()
+
+	person, err := structured.Extract[Person](context.Background(), provider, "Extract the person described here: "+text)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Name:       %s\n", person.Name)
+	fmt.Printf("Age:        %d\n", person.Age)
+	fmt.Printf("Occupation: %s\n", person.Occupation)
+}
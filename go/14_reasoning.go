@@ -7,78 +7,102 @@ Demonstrates:
 - Reasoning models that show step-by-step thinking
 - Three reasoning formats: raw, parsed, hidden
 - Three reasoning effort levels: low, medium, high
+- Streaming the reasoning trace and final answer incrementally
 - Cache optimization for cost savings
 
 What you'll learn:
 - How reasoning models differ from chat models
 - When to use different reasoning formats
+- How to stream a "thinking..." UI from SSE reasoning deltas
 - How to optimize message order for cache hits
 - Token usage and cost tracking
 
-Note: Uses Go standard library only
+Note: Uses the shared pkg/groqclient package instead of hand-rolled
+map[string]interface{} wire types.
 */
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
-)
-
-func callReasoning(messages []interface{}, formatType *string, effort string) (map[string]interface{}, error) {
-	requestData := map[string]interface{}{
-		"model":                 "openai/gpt-oss-20b",
-		"messages":              messages,
-		"temperature":           0.6,
-		"max_completion_tokens": 1024,
-		"reasoning_effort":      effort,
-	}
 
-	if formatType != nil {
-		requestData["reasoning_format"] = *formatType
-	}
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
 
-	requestBody, _ := json.Marshal(requestData)
+// ANSI codes used to render reasoning tokens dim and the final answer bright.
+const (
+	ansiDim    = "\033[2m"
+	ansiBright = "\033[1m"
+	ansiReset  = "\033[0m"
+)
 
-	req, err := http.NewRequest("POST", "https://api.demeterics.com/groq/v1/chat/completions", bytes.NewBuffer(requestBody))
+// streamReasoningAndAnswer streams a "parsed" reasoning request and prints
+// reasoning-token deltas dim, switching to bright text once the final
+// answer starts arriving.
+func streamReasoningAndAnswer(client *groqclient.Client, messages []groqclient.Message) {
+	events, err := client.CallReasoningStream(groqclient.ChatCompletionRequest{
+		Model:               "openai/gpt-oss-20b",
+		Messages:            messages,
+		Temperature:         0.6,
+		MaxCompletionTokens: 1024,
+		ReasoningFormat:     groqclient.ReasoningFormatParsed,
+		ReasoningEffort:     groqclient.ReasoningEffortMedium,
+	})
 	if err != nil {
-		return nil, err
+		fmt.Fprintf(os.Stderr, "Error starting stream: %v\n", err)
+		return
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", os.Getenv("DEMETERICS_API_KEY")))
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
+	inAnswer := false
+	for event := range events {
+		switch event.Type {
+		case groqclient.EventReasoningDelta:
+			fmt.Print(ansiDim + event.Reasoning + ansiReset)
+		case groqclient.EventContentDelta:
+			if !inAnswer {
+				fmt.Print("\n\n" + ansiBright)
+				inAnswer = true
+			}
+			fmt.Print(event.Content)
+		case groqclient.EventUsage:
+			fmt.Printf(ansiReset+"\n\nStreamed usage: %d prompt + %d completion tokens\n", event.Usage.PromptTokens, event.Usage.CompletionTokens)
+		case groqclient.EventDone:
+			fmt.Print(ansiReset + "\n")
+			if event.Err != nil {
+				fmt.Fprintf(os.Stderr, "Stream error: %v\n", event.Err)
+			}
+		}
 	}
-	defer resp.Body.Close()
-
-	body, _ := io.ReadAll(resp.Body)
+}
 
-	var result map[string]interface{}
-	json.Unmarshal(body, &result)
-	return result, nil
+func callReasoning(client *groqclient.Client, messages []groqclient.Message, format groqclient.ReasoningFormat, effort groqclient.ReasoningEffort) (*groqclient.ChatCompletionResponse, error) {
+	return client.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model:               "openai/gpt-oss-20b",
+		Messages:            messages,
+		Temperature:         0.6,
+		MaxCompletionTokens: 1024,
+		ReasoningFormat:     format,
+		ReasoningEffort:     effort,
+	})
 }
 
 func main() {
 	// Check for API key
-	if os.Getenv("DEMETERICS_API_KEY") == "" {
+	apiKey := os.Getenv("DEMETERICS_API_KEY")
+	if apiKey == "" {
 		fmt.Fprintln(os.Stderr, "Error: DEMETERICS_API_KEY not set")
 		fmt.Fprintln(os.Stderr, "Get your Managed LLM Key from: https://demeterics.com")
 		os.Exit(1)
 	}
 
+	client := groqclient.New(groqclient.DemetericsBaseURL, apiKey)
+
 	fmt.Println("========================================")
 	fmt.Println("Reasoning with openai/gpt-oss-20b")
 	fmt.Println("========================================\n")
 
 	query := "How many 'r' letters are in the word 'strawberry'? Think through this step-by-step."
-	messages := []interface{}{
-		map[string]interface{}{"role": "user", "content": query},
+	messages := []groqclient.Message{
+		groqclient.NewTextMessage("user", query),
 	}
 
 	fmt.Printf("Query: %s\n\n", query)
@@ -88,49 +112,39 @@ func main() {
 	fmt.Println("Demo 1: Raw Format (reasoning in <think> tags)")
 	fmt.Println("========================================\n")
 
-	rawResponse, err := callReasoning(messages, nil, "medium")
+	rawResponse, err := callReasoning(client, messages, "", groqclient.ReasoningEffortMedium)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 
-	choices := rawResponse["choices"].([]interface{})
-	message := choices[0].(map[string]interface{})["message"].(map[string]interface{})
-	content := message["content"].(string)
-
+	content := rawResponse.Choices[0].Message.Content
 	fmt.Println("Response (raw format):")
 	fmt.Println(content)
 	fmt.Println()
 
-	usage := rawResponse["usage"].(map[string]interface{})
-	promptTokens := usage["prompt_tokens"].(float64)
-	completionTokens := usage["completion_tokens"].(float64)
-	totalTokens := usage["total_tokens"].(float64)
-
+	usage := rawResponse.Usage
 	fmt.Println("Token Usage (Demo 1):")
-	fmt.Printf("  Prompt tokens: %.0f\n", promptTokens)
-	fmt.Printf("  Completion tokens: %.0f\n", completionTokens)
-	fmt.Printf("  Total tokens: %.0f\n\n", totalTokens)
+	fmt.Printf("  Prompt tokens: %d\n", usage.PromptTokens)
+	fmt.Printf("  Completion tokens: %d\n", usage.CompletionTokens)
+	fmt.Printf("  Total tokens: %d\n\n", usage.TotalTokens)
 
 	// Demo 2: Parsed format
 	fmt.Println("========================================")
 	fmt.Println("Demo 2: Parsed Format (separate reasoning field)")
 	fmt.Println("========================================\n")
 
-	parsedFormat := "parsed"
-	parsedResponse, _ := callReasoning(messages, &parsedFormat, "medium")
+	parsedResponse, _ := callReasoning(client, messages, groqclient.ReasoningFormatParsed, groqclient.ReasoningEffortMedium)
+	parsedMessage := parsedResponse.Choices[0].Message
 
-	parsedChoices := parsedResponse["choices"].([]interface{})
-	parsedMessage := parsedChoices[0].(map[string]interface{})["message"].(map[string]interface{})
-
-	if reasoning, ok := parsedMessage["reasoning"].(string); ok {
+	if parsedMessage.Reasoning != "" {
 		fmt.Println("Reasoning process:")
-		fmt.Println(reasoning)
+		fmt.Println(parsedMessage.Reasoning)
 		fmt.Println()
 	}
 
 	fmt.Println("Final answer:")
-	fmt.Println(parsedMessage["content"].(string))
+	fmt.Println(parsedMessage.Content)
 	fmt.Println()
 
 	// Demo 3: Hidden format
@@ -138,27 +152,29 @@ func main() {
 	fmt.Println("Demo 3: Hidden Format (only final answer)")
 	fmt.Println("========================================\n")
 
-	hiddenFormat := "hidden"
-	hiddenResponse, _ := callReasoning(messages, &hiddenFormat, "medium")
-
-	hiddenChoices := hiddenResponse["choices"].([]interface{})
-	hiddenMessage := hiddenChoices[0].(map[string]interface{})["message"].(map[string]interface{})
+	hiddenResponse, _ := callReasoning(client, messages, groqclient.ReasoningFormatHidden, groqclient.ReasoningEffortMedium)
 
 	fmt.Println("Response (hidden format):")
-	fmt.Println(hiddenMessage["content"].(string))
+	fmt.Println(hiddenResponse.Choices[0].Message.Content)
 	fmt.Println()
 
+	// Demo 4: Streaming with incremental reasoning trace
+	fmt.Println("========================================")
+	fmt.Println("Demo 4: Streaming (reasoning dim, answer bright)")
+	fmt.Println("========================================\n")
+
+	streamReasoningAndAnswer(client, messages)
+
 	// Cost calculation
 	fmt.Println("========================================")
 	fmt.Println("Cost Analysis")
 	fmt.Println("========================================\n")
 
 	inputCostPer1M := 0.10
-	cachedInputCostPer1M := 0.05
 	outputCostPer1M := 0.50
 
-	inputCost := (promptTokens * inputCostPer1M) / 1000000
-	outputCost := (completionTokens * outputCostPer1M) / 1000000
+	inputCost := (float64(usage.PromptTokens) * inputCostPer1M) / 1000000
+	outputCost := (float64(usage.CompletionTokens) * outputCostPer1M) / 1000000
 	totalCost := inputCost + outputCost
 
 	fmt.Println("Pricing (openai/gpt-oss-20b):")
@@ -167,8 +183,8 @@ func main() {
 	fmt.Println("  Output tokens: $0.50 per 1M\n")
 
 	fmt.Println("This request:")
-	fmt.Printf("  Input cost: $%.6f (%.0f tokens)\n", inputCost, promptTokens)
-	fmt.Printf("  Output cost: $%.6f (%.0f tokens)\n", outputCost, completionTokens)
+	fmt.Printf("  Input cost: $%.6f (%d tokens)\n", inputCost, usage.PromptTokens)
+	fmt.Printf("  Output cost: $%.6f (%d tokens)\n", outputCost, usage.CompletionTokens)
 	fmt.Printf("  Total cost: $%.6f\n\n", totalCost)
 
 	// Cache optimization explanation
@@ -4,7 +4,7 @@ package main
 Example 14: Text-to-Speech with OpenAI
 
 Demonstrates:
-- Converting text to spoken audio
+- Converting text to spoken audio via pkg/tts.OpenAISynthesizer
 - 11 different voices
 - Instructions and speed parameters
 - Multi-language support
@@ -13,80 +13,66 @@ Note: Uses Go standard library only
 */
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
-)
 
-func textToSpeech(text, voice string, options map[string]interface{}) ([]byte, error) {
-	requestData := map[string]interface{}{
-		"model": "gpt-4o-mini-tts",
-		"input": text,
-		"voice": voice,
-	}
-	
-	for k, v := range options {
-		requestData[k] = v
-	}
-	
-	requestBody, _ := json.Marshal(requestData)
-	
-	req, err := http.NewRequest("POST", "https://api.openai.com/v1/audio/speech", bytes.NewBuffer(requestBody))
-	if err != nil {
-		return nil, err
-	}
-	
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", os.Getenv("OPENAI_API_KEY")))
-	
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	
-	return io.ReadAll(resp.Body)
-}
+	"github.com/patdeg/ai101/go/pkg/tts"
+)
 
 func main() {
-	if os.Getenv("OPENAI_API_KEY") == "" {
+	apiKey := os.Getenv("OPENAI_API_KEY")
+	if apiKey == "" {
 		fmt.Fprintln(os.Stderr, "Error: OPENAI_API_KEY not set")
 		fmt.Fprintln(os.Stderr, "Get your key from: https://platform.openai.com")
 		os.Exit(1)
 	}
-	
+	synth := tts.NewOpenAISynthesizer(apiKey)
+	ctx := context.Background()
+
 	fmt.Println("========================================")
 	fmt.Println("Text-to-Speech with OpenAI")
 	fmt.Println("========================================\n")
-	
+
 	// Demo 1: Basic TTS
 	text := "Hello! This is an example of text-to-speech synthesis using OpenAI's affordable TTS model."
-	audio, err := textToSpeech(text, "alloy", nil)
+	size, err := tts.SynthesizeToFile(ctx, synth, tts.SpeechRequest{Input: text, Voice: "alloy"}, "demo1_alloy.mp3")
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
-	
-	os.WriteFile("demo1_alloy.mp3", audio, 0644)
-	fmt.Printf("✓ Audio created: demo1_alloy.mp3 (%d bytes)\n\n", len(audio))
-	
+	fmt.Printf("✓ Audio created: demo1_alloy.mp3 (%d bytes)\n\n", size)
+
 	// Demo 2: All voices
 	fmt.Println("Generating all 11 voices...\n")
-	voices := []string{"alloy", "ash", "ballad", "coral", "echo", "fable", 
-	                   "onyx", "nova", "sage", "shimmer", "verse"}
-	
+	voices := []string{"alloy", "ash", "ballad", "coral", "echo", "fable",
+		"onyx", "nova", "sage", "shimmer", "verse"}
+
 	comparisonText := "Welcome to OpenAI's text-to-speech demonstration."
 	for _, voice := range voices {
-		audio, _ := textToSpeech(comparisonText, voice, nil)
 		filename := fmt.Sprintf("voice_%s.mp3", voice)
-		os.WriteFile(filename, audio, 0644)
+		req := tts.SpeechRequest{Input: comparisonText, Voice: voice}
+		if _, err := tts.SynthesizeToFile(ctx, synth, req, filename); err != nil {
+			fmt.Fprintf(os.Stderr, "✗ %s: %v\n", voice, err)
+			continue
+		}
 		fmt.Printf("✓ %s: %s\n", voice, filename)
 	}
-	
+
+	// Demo 3: Speed and delivery instructions
+	fmt.Println("\nGenerating with Speed and Instructions...\n")
+	demo3 := tts.SpeechRequest{
+		Input:        "Take your time with this one.",
+		Voice:        "coral",
+		Speed:        0.7,
+		Instructions: "Speak slowly and calmly, like reading a bedtime story.",
+	}
+	if size, err := tts.SynthesizeToFile(ctx, synth, demo3, "demo3_slow_calm.mp3"); err != nil {
+		fmt.Fprintf(os.Stderr, "✗ demo3: %v\n", err)
+	} else {
+		fmt.Printf("✓ demo3_slow_calm.mp3 (%d bytes)\n", size)
+	}
+
 	fmt.Println("\n========================================")
 	fmt.Println("Cost Analysis")
 	fmt.Println("========================================\n")
@@ -0,0 +1,78 @@
+package main
+
+/*
+Example 15b: Text-to-Speech-to-Text Round Trip
+
+Demonstrates chaining pkg/tts and pkg/whisper across two providers:
+text -> mp3 (OpenAI's tts.OpenAISynthesizer, see 15_text_to_speech.go)
+-> transcript (Groq's whisper.Transcribe, see 08_whisper.go)
+-> .srt subtitle file (TranscriptionResponse.SRT, see pkg/whisper/subtitles.go)
+
+Requires both OPENAI_API_KEY (synthesis) and GROQ_API_KEY (transcription).
+
+Run:
+
+	export OPENAI_API_KEY='your_key_here'
+	export GROQ_API_KEY='your_key_here'
+	go run 15b_roundtrip.go
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/tts"
+	"github.com/patdeg/ai101/go/pkg/whisper"
+)
+
+func main() {
+	openaiKey := os.Getenv("OPENAI_API_KEY")
+	if openaiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: OPENAI_API_KEY not set")
+		os.Exit(1)
+	}
+	groqKey := os.Getenv("GROQ_API_KEY")
+	if groqKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY not set")
+		os.Exit(1)
+	}
+
+	fmt.Println("========================================")
+	fmt.Println("Text-to-Speech-to-Text Round Trip")
+	fmt.Println("========================================\n")
+
+	text := "The quick brown fox jumps over the lazy dog, proving that this sentence uses every letter of the alphabet at least once."
+
+	fmt.Println("1. Synthesizing speech with OpenAI...")
+	synth := tts.NewOpenAISynthesizer(openaiKey)
+	audioPath := "roundtrip.mp3"
+	size, err := tts.SynthesizeToFile(context.Background(), synth, tts.SpeechRequest{Input: text, Voice: "alloy"}, audioPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error synthesizing speech: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("✓ %s (%d bytes)\n\n", audioPath, size)
+
+	fmt.Println("2. Transcribing it back with Groq Whisper...")
+	result, err := whisper.Transcribe(groqKey, whisper.Request{
+		AudioPath: audioPath,
+		Format:    whisper.FormatVerboseJSON,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error transcribing audio: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("✓ Transcript: %q\n\n", result.Text)
+
+	fmt.Println("3. Writing subtitles...")
+	srtPath := "roundtrip.srt"
+	if err := os.WriteFile(srtPath, []byte(result.SRT()), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing subtitles: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("✓ %s\n\n", srtPath)
+
+	fmt.Println("Original:   ", text)
+	fmt.Println("Transcribed:", result.Text)
+}
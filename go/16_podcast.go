@@ -3,7 +3,8 @@
 ////////////////////////////////////////////////////////////////////////////////
 //
 // This example demonstrates how to generate a podcast with multiple speakers
-// using Demeterics multi-speaker TTS API in a SINGLE API call.
+// using Demeterics multi-speaker TTS API in a SINGLE API call, via
+// pkg/tts.SynthesizeMultiSpeaker.
 //
 // What you'll learn:
 // - Multi-speaker audio generation with distinct voices
@@ -23,28 +24,15 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
-)
-
-// Step 1: Define request structures (Demeterics format)
-type Speaker struct {
-	Name  string `json:"name"`
-	Voice string `json:"voice"`
-}
 
-type Request struct {
-	Provider string    `json:"provider"`
-	Input    string    `json:"input"`
-	Speakers []Speaker `json:"speakers"`
-}
+	"github.com/patdeg/ai101/go/pkg/tts"
+)
 
 func main() {
-	// Step 2: Check for API key
+	// Step 1: Check for API key
 	apiKey := os.Getenv("DEMETERICS_API_KEY")
 	if apiKey == "" {
 		fmt.Println("Error: DEMETERICS_API_KEY not set")
@@ -52,7 +40,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Step 3: Define the podcast script
+	// Step 2: Define the podcast script
 	// Format: "SpeakerName: dialogue text"
 	// Speaker names must match exactly in speakers array
 	podcastScript := `Alex: Hey, welcome back to The Deep Dive! I am Alex.
@@ -76,12 +64,12 @@ Sam: Very complicated. It is really the story of a European arriving somewhere t
 Alex: That is the deep dive for today. Thanks for listening, everyone!
 Sam: See you next time!`
 
-	// Step 4: Configure voices for each speaker
+	// Step 3: Configure voices for each speaker
 	// Available voices (30 total):
 	//   Puck (upbeat), Kore (firm), Charon (informative), Zephyr (bright),
 	//   Fenrir (excitable), Leda (youthful), Aoede (breezy), Sulafat (warm),
 	//   Achird (friendly), and 21 others.
-	speakers := []Speaker{
+	speakers := []tts.Speaker{
 		{Name: "Alex", Voice: "Charon"}, // Informative, clear - explains concepts
 		{Name: "Sam", Voice: "Puck"},    // Upbeat - energetic, enthusiastic
 	}
@@ -99,56 +87,24 @@ Sam: See you next time!`
 	fmt.Println("")
 	fmt.Println("Generating podcast audio...")
 
-	// Step 5: Build the request (Demeterics format)
-	request := Request{
+	// Step 4: Build the request and synthesize
+	request := tts.MultiSpeakerRequest{
 		Provider: "gemini",
-		Input:    podcastScript,
+		Script:   podcastScript,
 		Speakers: speakers,
 	}
 
-	requestBody, err := json.Marshal(request)
-	if err != nil {
-		fmt.Printf("Error marshaling request: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Step 6: Make the API request
-	url := "https://api.demeterics.com/tts/v1/generate"
-	req, err := http.NewRequest("POST", url, bytes.NewReader(requestBody))
-	if err != nil {
-		fmt.Printf("Error creating request: %v\n", err)
-		os.Exit(1)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	outputFile := "columbus_podcast.wav"
+	f, err := os.Create(outputFile)
 	if err != nil {
-		fmt.Printf("Error making request: %v\n", err)
+		fmt.Printf("Error creating %s: %v\n", outputFile, err)
 		os.Exit(1)
 	}
-	defer resp.Body.Close()
+	defer f.Close()
 
-	// Step 7: Check for errors
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-		fmt.Printf("API Error: HTTP %d\n", resp.StatusCode)
-		fmt.Println(string(body))
-		os.Exit(1)
-	}
-
-	// Step 8: Save the audio file directly (Demeterics returns audio bytes)
-	audioBytes, err := io.ReadAll(resp.Body)
+	size, err := tts.SynthesizeMultiSpeaker(context.Background(), apiKey, request, f)
 	if err != nil {
-		fmt.Printf("Error reading response: %v\n", err)
-		os.Exit(1)
-	}
-
-	outputFile := "columbus_podcast.wav"
-	if err := os.WriteFile(outputFile, audioBytes, 0644); err != nil {
-		fmt.Printf("Error writing file: %v\n", err)
+		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
 
@@ -157,7 +113,7 @@ Sam: See you next time!`
 	fmt.Println("")
 	fmt.Println("Output:")
 	fmt.Printf("  File: %s\n", outputFile)
-	fmt.Printf("  Size: %d bytes (%d KB)\n", len(audioBytes), len(audioBytes)/1024)
+	fmt.Printf("  Size: %d bytes (%d KB)\n", size, size/1024)
 	fmt.Println("")
 	fmt.Println("To play:")
 	fmt.Printf("  mpv %s\n", outputFile)
@@ -4,17 +4,39 @@
 // - Demeterics Council API for content evaluation
 // - Multi-persona voting to select the best option
 // - Vote tallying and consensus metrics
+// - Condorcet winner, Borda count, and Shannon entropy disagreement score
+//   as tiebreakers beyond plain majority vote
+// - Routing the request/response pair through pkg/audit, capturing each
+//   persona's vote (AUDIT_SINK selects the sink; see pkg/audit)
+// - Streaming persona-by-persona results (-stream) with a live ASCII
+//   vote tally redrawn in place via ANSI cursor control, stopping early
+//   once the leader's lead can no longer be overturned by the personas
+//   still outstanding; -json-stream emits one JSON object per persona
+//   event instead, for downstream piping
+//
+// Note: the N per-persona evaluations happen server-side inside a single
+// /council/v1/evaluate call, not as separate client-side completions, so
+// there's no per-persona sub-call here to route through pkg/completer -
+// callCouncil's dual-key (DEMETERICS_API_KEY;GROQ_API_KEY) auth is how the
+// Demeterics-hosted Council chooses its own backing model per persona.
 package main
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"os"
 	"sort"
 	"strings"
+	"time"
+
+	"github.com/patdeg/ai101/go/pkg/audit"
 )
 
 // Stories from December 3rd in History (simplified)
@@ -39,6 +61,14 @@ type CouncilRequest struct {
 	Question    string `json:"question"`
 	Content     string `json:"content"`
 	NumPersonas int    `json:"num_personas"`
+	// IncludeRankings asks each persona for a full preference order over
+	// every option, not just its top Vote. The API may not support this
+	// yet; personaRanking falls back to a single-item ranking when a
+	// persona's Rankings comes back empty.
+	IncludeRankings bool `json:"include_rankings,omitempty"`
+	// Stream asks for /council/v1/evaluate/stream's persona-by-persona
+	// SSE response instead of callCouncil's single blocking response.
+	Stream bool `json:"stream,omitempty"`
 }
 
 // PersonaResponse represents a single persona's evaluation
@@ -50,6 +80,9 @@ type PersonaResponse struct {
 	VoteReason      string   `json:"vote_reason"`
 	Guidance        string   `json:"guidance"`
 	ClarityFlags    []string `json:"clarity_flags"`
+	// Rankings is the persona's full preference order, best first, when
+	// CouncilRequest.IncludeRankings was set and the API returned one.
+	Rankings []string `json:"rankings,omitempty"`
 }
 
 // CouncilStats contains voting statistics
@@ -60,6 +93,13 @@ type CouncilStats struct {
 	VoteBreakdown          map[string]int `json:"vote_breakdown"`
 	MajorityVote           string         `json:"majority_vote"`
 	VoteConsensus          string         `json:"vote_consensus"`
+
+	// CondorcetWinner, BordaWinner, and DisagreementScore are derived
+	// client-side by computeConsensusMetrics from each persona's
+	// ranking, not returned by the API - see that function's doc comment.
+	CondorcetWinner   string  `json:"-"`
+	BordaWinner       string  `json:"-"`
+	DisagreementScore float64 `json:"-"`
 }
 
 // UsageInfo contains billing information
@@ -82,7 +122,7 @@ type CouncilResponse struct {
 	} `json:"error"`
 }
 
-func callCouncil(question, content string, numPersonas int) (*CouncilResponse, error) {
+func callCouncil(question, content string, numPersonas int, includeRankings bool) (*CouncilResponse, error) {
 	demetericsKey := os.Getenv("DEMETERICS_API_KEY")
 	groqKey := os.Getenv("GROQ_API_KEY")
 
@@ -94,9 +134,10 @@ func callCouncil(question, content string, numPersonas int) (*CouncilResponse, e
 	authHeader := fmt.Sprintf("%s;%s", demetericsKey, groqKey)
 
 	reqBody := CouncilRequest{
-		Question:    question,
-		Content:     content,
-		NumPersonas: numPersonas,
+		Question:        question,
+		Content:         content,
+		NumPersonas:     numPersonas,
+		IncludeRankings: includeRankings,
 	}
 
 	jsonBody, err := json.Marshal(reqBody)
@@ -129,9 +170,365 @@ func callCouncil(question, content string, numPersonas int) (*CouncilResponse, e
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
+	if result.Error == nil {
+		computeConsensusMetrics(&result)
+	}
+
 	return &result, nil
 }
 
+// computeConsensusMetrics fills in CouncilStats.CondorcetWinner, BordaWinner,
+// and DisagreementScore from each persona's preference ranking. The API
+// returns only a single Vote per persona unless IncludeRankings was set
+// and honored, so personaRanking falls back to a single-item ranking
+// synthesized from Vote. A single-item ranking contributes no pairwise
+// wins and no Borda points, so when no persona returns Rankings there is
+// no Condorcet winner, BordaWinner is the alphabetically first option,
+// and VoteConsensus is set to "cycle".
+func computeConsensusMetrics(result *CouncilResponse) {
+	options := make([]string, 0, len(result.Stats.VoteBreakdown))
+	for option := range result.Stats.VoteBreakdown {
+		options = append(options, option)
+	}
+	sort.Strings(options)
+	if len(options) == 0 {
+		return
+	}
+
+	rankings := make([][]string, 0, len(result.PersonaResponses))
+	for _, persona := range result.PersonaResponses {
+		if ranking := personaRanking(persona); ranking != nil {
+			rankings = append(rankings, ranking)
+		}
+	}
+
+	wins := pairwiseWins(rankings)
+	borda := bordaWinner(options, bordaCounts(rankings))
+
+	if winner, ok := condorcetWinner(options, wins); ok {
+		result.Stats.CondorcetWinner = winner
+	} else {
+		result.Stats.CondorcetWinner = borda
+		result.Stats.VoteConsensus = "cycle"
+	}
+	result.Stats.BordaWinner = borda
+	result.Stats.DisagreementScore = disagreementScore(result.Stats.VoteBreakdown)
+}
+
+// personaRanking returns persona's full preference order, best first,
+// falling back to a single-item ranking built from Vote when the API
+// didn't return Rankings.
+func personaRanking(persona PersonaResponse) []string {
+	if len(persona.Rankings) > 0 {
+		return persona.Rankings
+	}
+	if persona.Vote == "" {
+		return nil
+	}
+	return []string{persona.Vote}
+}
+
+// pairwiseWins counts, for each ordered pair of options appearing in a
+// ranking, how many personas preferred the first over the second.
+func pairwiseWins(rankings [][]string) map[string]map[string]int {
+	wins := make(map[string]map[string]int)
+	for _, ranking := range rankings {
+		for i := 0; i < len(ranking); i++ {
+			for j := i + 1; j < len(ranking); j++ {
+				winner, loser := ranking[i], ranking[j]
+				if wins[winner] == nil {
+					wins[winner] = make(map[string]int)
+				}
+				wins[winner][loser]++
+			}
+		}
+	}
+	return wins
+}
+
+// condorcetWinner returns the option that beats every other option
+// pairwise, and whether one exists. With no Condorcet winner (a cycle:
+// A beats B, B beats C, C beats A), ok is false and the caller should
+// fall back to the Borda winner.
+func condorcetWinner(options []string, wins map[string]map[string]int) (string, bool) {
+	for _, candidate := range options {
+		beatsAll := true
+		for _, other := range options {
+			if other == candidate {
+				continue
+			}
+			if wins[candidate][other] <= wins[other][candidate] {
+				beatsAll = false
+				break
+			}
+		}
+		if beatsAll {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// bordaCounts awards each option points equal to the number of
+// lower-ranked options in each persona's ranking, then sums across
+// personas - the standard Borda count.
+func bordaCounts(rankings [][]string) map[string]int {
+	counts := make(map[string]int)
+	for _, ranking := range rankings {
+		n := len(ranking)
+		for i, option := range ranking {
+			counts[option] += n - 1 - i
+		}
+	}
+	return counts
+}
+
+// bordaWinner returns the option with the highest Borda count, breaking
+// ties by options' sorted order.
+func bordaWinner(options []string, counts map[string]int) string {
+	best := options[0]
+	bestScore := counts[best]
+	for _, option := range options[1:] {
+		if counts[option] > bestScore {
+			best, bestScore = option, counts[option]
+		}
+	}
+	return best
+}
+
+// disagreementScore is the Shannon entropy of the vote distribution,
+// normalized by log2(number of options) to a [0,1] disagreement signal:
+// 0 when every persona votes the same way, 1 when votes are spread as
+// evenly as possible across all options.
+func disagreementScore(breakdown map[string]int) float64 {
+	if len(breakdown) <= 1 {
+		return 0
+	}
+
+	total := 0
+	for _, count := range breakdown {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+
+	entropy := 0.0
+	for _, count := range breakdown {
+		if count == 0 {
+			continue
+		}
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy / math.Log2(float64(len(breakdown)))
+}
+
+// PersonaEvent is one item streamed by /council/v1/evaluate/stream: one
+// "data: {...}" line per persona as it finishes, OpenAI-chunk-shaped
+// like pkg/groqclient's streamChunk (see stream.go) rather than
+// Anthropic's blank-line-delimited blocks (01b_stream_anthropic.go), plus
+// a final event carrying the complete CouncilResponse once every persona
+// has reported in.
+type PersonaEvent struct {
+	Persona PersonaResponse  `json:"persona"`
+	Final   *CouncilResponse `json:"final,omitempty"`
+	Err     error            `json:"-"`
+}
+
+// callCouncilStream is callCouncil's streaming sibling: it opens
+// /council/v1/evaluate/stream and returns a channel of PersonaEvent,
+// closing it once the stream ends. Canceling ctx (e.g. after an early
+// stop - see displayResultsLive) aborts the underlying request instead
+// of waiting out the remaining personas.
+func callCouncilStream(ctx context.Context, question, content string, numPersonas int, includeRankings bool) (<-chan PersonaEvent, error) {
+	demetericsKey := os.Getenv("DEMETERICS_API_KEY")
+	groqKey := os.Getenv("GROQ_API_KEY")
+	if demetericsKey == "" || groqKey == "" {
+		return nil, fmt.Errorf("both DEMETERICS_API_KEY and GROQ_API_KEY must be set")
+	}
+	authHeader := fmt.Sprintf("%s;%s", demetericsKey, groqKey)
+
+	reqBody := CouncilRequest{
+		Question:        question,
+		Content:         content,
+		NumPersonas:     numPersonas,
+		IncludeRankings: includeRankings,
+		Stream:          true,
+	}
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", "https://api.demeterics.com/council/v1/evaluate/stream", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+authHeader)
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	events := make(chan PersonaEvent)
+	go func() {
+		defer resp.Body.Close()
+		defer close(events)
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+		for scanner.Scan() {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload == "[DONE]" {
+				return
+			}
+
+			var event PersonaEvent
+			if err := json.Unmarshal([]byte(payload), &event); err != nil {
+				events <- PersonaEvent{Err: fmt.Errorf("failed to parse stream event: %w (raw: %s)", err, payload)}
+				return
+			}
+			if event.Final != nil && event.Final.Error == nil {
+				computeConsensusMetrics(event.Final)
+			}
+			events <- event
+		}
+
+		if err := scanner.Err(); err != nil && ctx.Err() == nil {
+			events <- PersonaEvent{Err: fmt.Errorf("stream read failed: %w", err)}
+		}
+	}()
+
+	return events, nil
+}
+
+// displayResultsLive consumes events, printing either one JSON line per
+// persona (jsonStream) or a live ASCII vote tally redrawn in place as
+// each persona reports in. It calls cancel and stops once the leading
+// option's lead can no longer be overturned by the personas still
+// outstanding - a decided outcome, not just a likely one - and returns
+// the final CouncilResponse if one was received before that happened.
+func displayResultsLive(cancel context.CancelFunc, events <-chan PersonaEvent, totalPersonas int, jsonStream bool) *CouncilResponse {
+	tally := make(map[string]int)
+	received := 0
+	linesDrawn := 0
+
+	for event := range events {
+		if event.Err != nil {
+			fmt.Fprintln(os.Stderr, "Warning: stream error:", event.Err)
+			return nil
+		}
+		if event.Final != nil {
+			return event.Final
+		}
+
+		if jsonStream {
+			line, _ := json.Marshal(event.Persona)
+			fmt.Println(string(line))
+			continue
+		}
+
+		tally[event.Persona.Vote]++
+		received++
+		linesDrawn = redrawTally(tally, linesDrawn)
+
+		if leadDecided(tally, totalPersonas, received) {
+			fmt.Println("\nOutcome decided early - remaining personas can't change the leader. Stopping stream.")
+			cancel()
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// redrawTally reprints the vote tally in place: it moves the cursor up
+// previousLines lines via ANSI control sequences, clears to the end of
+// the screen, then redraws every option's bar. It returns the number of
+// lines it drew, so the next call knows how far to rewind.
+func redrawTally(tally map[string]int, previousLines int) int {
+	options := make([]string, 0, len(tally))
+	for option := range tally {
+		options = append(options, option)
+	}
+	sort.Strings(options)
+
+	if previousLines > 0 {
+		fmt.Printf("\033[%dA\033[J", previousLines)
+	}
+	for _, option := range options {
+		fmt.Printf("  %s: %s (%d)\n", option, strings.Repeat("*", tally[option]), tally[option])
+	}
+	return len(options)
+}
+
+// leadDecided reports whether the leading option's vote count already
+// exceeds every other option's count by more than the number of
+// personas still outstanding - meaning no remaining vote can change the
+// winner.
+func leadDecided(tally map[string]int, totalPersonas, received int) bool {
+	remaining := totalPersonas - received
+	if remaining <= 0 {
+		return false
+	}
+
+	lead, runnerUp := 0, 0
+	for _, count := range tally {
+		switch {
+		case count > lead:
+			runnerUp = lead
+			lead = count
+		case count > runnerUp:
+			runnerUp = count
+		}
+	}
+	return lead-runnerUp > remaining
+}
+
+// runStreaming drives the -stream demo: it opens callCouncilStream, feeds
+// its events through displayResultsLive, and - if the stream ran to
+// completion rather than stopping early - finishes with the same
+// displayResults/logAudit the blocking path uses.
+func runStreaming(jsonStream bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := callCouncilStream(
+		ctx,
+		"Which story would you most want to watch as a short video?",
+		stories,
+		8,
+		true,
+	)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	final := displayResultsLive(cancel, events, 8, jsonStream)
+	if final == nil {
+		return
+	}
+
+	displayResults(final)
+	logAudit(final)
+}
+
 func displayResults(result *CouncilResponse) {
 	fmt.Println(strings.Repeat("=", 60))
 	fmt.Println("COUNCIL VOTING RESULTS")
@@ -147,6 +544,9 @@ func displayResults(result *CouncilResponse) {
 	// Winner and consensus
 	fmt.Printf("Winner: %s\n", result.Stats.MajorityVote)
 	fmt.Printf("Consensus: %s\n", result.Stats.VoteConsensus)
+	fmt.Printf("Condorcet winner: %s\n", result.Stats.CondorcetWinner)
+	fmt.Printf("Borda winner: %s\n", result.Stats.BordaWinner)
+	fmt.Printf("Disagreement score: %.2f (0 = unanimous, 1 = maximally split)\n", result.Stats.DisagreementScore)
 	fmt.Println()
 
 	// Vote breakdown (sorted by vote count)
@@ -200,6 +600,13 @@ func displayResults(result *CouncilResponse) {
 }
 
 func main() {
+	stream := flag.Bool("stream", false, "use /council/v1/evaluate/stream with a live vote tally instead of waiting for all personas")
+	jsonStream := flag.Bool("json-stream", false, "emit one JSON object per persona event instead of the live ASCII tally (implies -stream)")
+	flag.Parse()
+	if *jsonStream {
+		*stream = true
+	}
+
 	fmt.Println(strings.Repeat("=", 60))
 	fmt.Println("Council Voting Demo - Best Story Selection")
 	fmt.Println(strings.Repeat("=", 60))
@@ -209,6 +616,14 @@ func main() {
 	fmt.Println("Stories:")
 	fmt.Println(stories)
 	fmt.Println()
+
+	if *stream {
+		fmt.Println("Streaming from Council API with 8 personas...")
+		fmt.Println()
+		runStreaming(*jsonStream)
+		return
+	}
+
 	fmt.Println("Sending to Council API with 8 personas...")
 	fmt.Println()
 
@@ -216,6 +631,7 @@ func main() {
 		"Which story would you most want to watch as a short video?",
 		stories,
 		8,
+		true,
 	)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -223,4 +639,33 @@ func main() {
 	}
 
 	displayResults(result)
+	logAudit(result)
+}
+
+// logAudit records this run's persona votes and cost through pkg/audit.
+// Council tallies one total token count per call rather than a
+// prompt/completion split, so CompletionTokens carries that total and
+// PromptTokens is left at zero.
+func logAudit(result *CouncilResponse) {
+	auditLogger, err := audit.FromEnv()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Warning: failed to build audit logger:", err)
+		return
+	}
+
+	votes := make(map[string]string, len(result.PersonaResponses))
+	for _, persona := range result.PersonaResponses {
+		votes[persona.Name] = persona.Vote
+	}
+
+	event := audit.Event{
+		Endpoint:         "17_council_voting",
+		Timestamp:        time.Now(),
+		CompletionTokens: result.Usage.TotalTokens,
+		CostUSD:          result.Usage.TotalCostUSD,
+		PersonaVotes:     votes,
+	}
+	if err := auditLogger.Log(context.Background(), event); err != nil {
+		fmt.Fprintln(os.Stderr, "Warning: failed to write audit log:", err)
+	}
 }
@@ -0,0 +1,149 @@
+package main
+
+/*
+Example 18: Safety-Checked Vision Reasoning
+
+Chains two models into one pipeline:
+  1. meta-llama/llama-guard-4-12b checks an image for safety.
+  2. If (and only if) the image is safe, the SAME image bytes are
+     forwarded to openai/gpt-oss-20b with reasoning_format: "parsed" to
+     explain, step-by-step, what the image shows.
+
+Demonstrates:
+- Composing two chat-completion calls into a single real-world pipeline
+- Reading the image file once and reusing its bytes for both stages
+  (each request base64-encodes them again)
+- Reporting per-stage token usage and cost alongside a combined total
+
+Usage:
+    go run 18_vision_safety_reasoning.go <image-path>
+
+Environment:
+    GROQ_API_KEY - Your Groq API key
+*/
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+// stageResult captures what one pipeline stage cost, for the final summary.
+type stageResult struct {
+	name              string
+	usage             groqclient.Usage
+	costPerMillionIn  float64
+	costPerMillionOut float64
+}
+
+func (s stageResult) cost() float64 {
+	in := float64(s.usage.PromptTokens) / 1_000_000 * s.costPerMillionIn
+	out := float64(s.usage.CompletionTokens) / 1_000_000 * s.costPerMillionOut
+	return in + out
+}
+
+// runSafetyCheck sends imageData to LlamaGuard and reports whether it's safe.
+func runSafetyCheck(client *groqclient.Client, imageData []byte, mimeType string) (bool, string, stageResult, error) {
+	resp, err := client.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model: "meta-llama/llama-guard-4-12b",
+		Messages: []groqclient.Message{
+			{Role: "user", Content: groqclient.ImageContentParts("Check this image for safety", imageData, mimeType)},
+		},
+		MaxTokens: 100,
+	})
+	if err != nil {
+		return false, "", stageResult{}, fmt.Errorf("safety check failed: %w", err)
+	}
+
+	verdict := resp.Choices[0].Message.Content
+	stage := stageResult{name: "LlamaGuard safety check", usage: resp.Usage, costPerMillionIn: 0.20, costPerMillionOut: 0.20}
+	isSafe := len(verdict) >= 4 && verdict[:4] == "safe"
+	return isSafe, verdict, stage, nil
+}
+
+// runReasoningOverImage forwards the same image bytes to a reasoning model
+// and asks it to explain what it sees, step-by-step.
+func runReasoningOverImage(client *groqclient.Client, imageData []byte, mimeType string) (string, stageResult, error) {
+	resp, err := client.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model: "openai/gpt-oss-20b",
+		Messages: []groqclient.Message{
+			{Role: "user", Content: groqclient.ImageContentParts("Describe what this image shows, step-by-step.", imageData, mimeType)},
+		},
+		MaxCompletionTokens: 1024,
+		ReasoningFormat:     groqclient.ReasoningFormatParsed,
+		ReasoningEffort:     groqclient.ReasoningEffortMedium,
+	})
+	if err != nil {
+		return "", stageResult{}, fmt.Errorf("reasoning call failed: %w", err)
+	}
+
+	stage := stageResult{name: "gpt-oss-20b reasoning", usage: resp.Usage, costPerMillionIn: 0.10, costPerMillionOut: 0.50}
+	return resp.Choices[0].Message.Reasoning, stage, nil
+}
+
+func main() {
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY environment variable not set")
+		os.Exit(1)
+	}
+
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "Usage: go run 18_vision_safety_reasoning.go <image-path>")
+		os.Exit(1)
+	}
+	imagePath := os.Args[1]
+
+	// Read once; both stages reuse this buffer.
+	imageData, err := os.ReadFile(imagePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading image: %v\n", err)
+		os.Exit(1)
+	}
+	mimeType := groqclient.SniffImageMimeType(imageData)
+
+	client := groqclient.New(groqclient.GroqBaseURL, apiKey)
+
+	fmt.Println("========================================")
+	fmt.Println("Stage 1: Safety check (LlamaGuard)")
+	fmt.Println("========================================\n")
+
+	isSafe, verdict, safetyStage, err := runSafetyCheck(client, imageData, mimeType)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Verdict: %s\n", verdict)
+
+	stages := []stageResult{safetyStage}
+
+	if !isSafe {
+		fmt.Println("\nImage flagged as unsafe — skipping the reasoning stage.")
+	} else {
+		fmt.Println("\n========================================")
+		fmt.Println("Stage 2: Reasoning over the image (gpt-oss-20b)")
+		fmt.Println("========================================\n")
+
+		reasoning, reasoningStage, err := runReasoningOverImage(client, imageData, mimeType)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("Reasoning trace:")
+		fmt.Println(reasoning)
+		stages = append(stages, reasoningStage)
+	}
+
+	fmt.Println("\n========================================")
+	fmt.Println("Per-stage usage and cost")
+	fmt.Println("========================================\n")
+
+	var total float64
+	for _, s := range stages {
+		cost := s.cost()
+		total += cost
+		fmt.Printf("%-28s prompt=%-5d completion=%-5d cost=$%.6f\n", s.name, s.usage.PromptTokens, s.usage.CompletionTokens, cost)
+	}
+	fmt.Printf("\nTotal pipeline cost: $%.6f\n", total)
+}
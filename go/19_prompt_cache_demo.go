@@ -0,0 +1,122 @@
+package main
+
+/*
+Example 19: Prompt-Cache Hit Analyzer and Reorderer
+
+Example 13 explains Groq's prompt caching but never shows it working. This
+example fires the same "strawberry" query three times with messages in an
+arbitrary order, then three more times after pkg/promptcache has reordered
+them (system first, then previously-seen turns, then the newest question) — and
+prints a side-by-side table of cached-token counts and cost so the
+optimization is visible, not just described.
+
+Usage:
+    go run 19_prompt_cache_demo.go
+
+Environment:
+    DEMETERICS_API_KEY - Your Demeterics Managed LLM Key
+*/
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+	"github.com/patdeg/ai101/go/pkg/promptcache"
+)
+
+const systemPrompt = "You are a meticulous assistant that double-checks spelling before answering."
+
+var gptOss20bPricing = promptcache.PricePerMillion{Input: 0.10, CachedInput: 0.05}
+
+func runQuery(client *groqclient.Client, messages []groqclient.Message) (*groqclient.ChatCompletionResponse, error) {
+	return client.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model:               "openai/gpt-oss-20b",
+		Messages:            messages,
+		MaxCompletionTokens: 256,
+		ReasoningEffort:     groqclient.ReasoningEffortLow,
+	})
+}
+
+func main() {
+	apiKey := os.Getenv("DEMETERICS_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: DEMETERICS_API_KEY not set")
+		os.Exit(1)
+	}
+
+	client := groqclient.New(groqclient.DemetericsBaseURL, apiKey)
+	query := "How many 'r' letters are in the word 'strawberry'? Think through this step-by-step."
+
+	history, err := promptcache.LoadHistory("")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading prompt history: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("========================================")
+	fmt.Println("Without reordering")
+	fmt.Println("========================================\n")
+
+	type row struct {
+		label    string
+		analysis promptcache.Analysis
+	}
+	var rows []row
+
+	for i := 1; i <= 3; i++ {
+		// Deliberately arbitrary order: the volatile question first, the
+		// constant system prompt last.
+		messages := []groqclient.Message{
+			groqclient.NewTextMessage("user", query),
+			groqclient.NewTextMessage("system", systemPrompt),
+		}
+
+		resp, err := runQuery(client, messages)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Call %d failed: %v\n", i, err)
+			os.Exit(1)
+		}
+		analysis := promptcache.Analyze(resp, gptOss20bPricing)
+		rows = append(rows, row{label: fmt.Sprintf("unordered #%d", i), analysis: analysis})
+	}
+
+	fmt.Println("\n========================================")
+	fmt.Println("With reordering (system first, newest question last)")
+	fmt.Println("========================================\n")
+
+	for i := 1; i <= 3; i++ {
+		messages := []groqclient.Message{
+			groqclient.NewTextMessage("user", query),
+			groqclient.NewTextMessage("system", systemPrompt),
+		}
+		messages = history.Reorder(messages)
+
+		resp, err := runQuery(client, messages)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Call %d failed: %v\n", i, err)
+			os.Exit(1)
+		}
+		analysis := promptcache.Analyze(resp, gptOss20bPricing)
+		rows = append(rows, row{label: fmt.Sprintf("reordered #%d", i), analysis: analysis})
+
+		for _, m := range messages {
+			if text, ok := m.Content.(string); ok {
+				history.Observe(text)
+			}
+		}
+	}
+
+	if err := history.Save(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to persist prompt history: %v\n", err)
+	}
+
+	fmt.Println("\n========================================")
+	fmt.Println("Side-by-side: cached tokens and cost")
+	fmt.Println("========================================\n")
+	fmt.Printf("%-16s %8s %8s %8s %10s\n", "call", "prompt", "cached", "hit%", "cost")
+	for _, r := range rows {
+		a := r.analysis
+		fmt.Printf("%-16s %8d %8d %7.1f%% $%.6f\n", r.label, a.PromptTokens, a.CachedTokens, a.HitRatio*100, a.ActualCost)
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+/*
+Example 21: Template-Driven Agent
+
+Demonstrates pkg/prompttemplate's agent mode: a compiled [[ ]] template
+renders the user prompt, then RunAgent drives the same tool-calling loop
+10_tool_use.go writes out by hand, but reusable across any template/tool
+combination instead of one-off per example.
+
+Uses Go standard library only.
+*/
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+	"github.com/patdeg/ai101/go/pkg/prompttemplate"
+)
+
+const (
+	agentModel  = "meta-llama/llama-4-scout-17b-16e-instruct"
+	templateDir = "../templates"
+)
+
+// createAgentTemplate creates the example template this agent renders, if
+// it isn't already there — the same self-bootstrapping convention
+// 03_prompt_template.go uses for essay_writer.txt.
+func createAgentTemplate() error {
+	path := filepath.Join(templateDir, "agent_task.tmpl")
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(templateDir, 0755); err != nil {
+		return fmt.Errorf("failed to create template directory: %w", err)
+	}
+
+	content := `You are helping with a task in the [[.Category]] category.
+
+Task: [[.Topic]]
+
+Use any tools available to you to complete the task, then give a short,
+clear final answer.
+`
+	return os.WriteFile(path, []byte(content), 0644)
+}
+
+// calculatorTool adds two numbers, standing in for
+// a real external tool without pulling in a dependency or a network call.
+type calculatorTool struct{}
+
+func (calculatorTool) Name() string        { return "calculator" }
+func (calculatorTool) Description() string { return "Adds two numbers together" }
+func (calculatorTool) Parameters() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"a": map[string]interface{}{"type": "number", "description": "First number"},
+			"b": map[string]interface{}{"type": "number", "description": "Second number"},
+		},
+		"required": []string{"a", "b"},
+	}
+}
+
+func (calculatorTool) Execute(args map[string]interface{}) (string, error) {
+	a, aOK := args["a"].(float64)
+	b, bOK := args["b"].(float64)
+	if !aOK || !bOK {
+		return "", fmt.Errorf("calculator requires numeric a and b")
+	}
+	return fmt.Sprintf("%g", a+b), nil
+}
+
+func main() {
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY environment variable is not set")
+		fmt.Fprintln(os.Stderr, "Get your key at: https://console.groq.com")
+		os.Exit(1)
+	}
+
+	if err := createAgentTemplate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating template: %v\n", err)
+		os.Exit(1)
+	}
+
+	registry, err := prompttemplate.NewRegistry(templateDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading templates: %v\n", err)
+		os.Exit(1)
+	}
+
+	userPrompt, err := registry.Render("agent_task", map[string]interface{}{
+		"Category": "math",
+		"Topic":    "What is 47 plus 55? Use the calculator tool, then explain the result in one sentence.",
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error rendering template: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("========================================")
+	fmt.Println("Template-Driven Agent")
+	fmt.Println("========================================\n")
+	fmt.Printf("Rendered prompt:\n%s\n\n", userPrompt)
+
+	client := groqclient.New(groqclient.GroqBaseURL, apiKey)
+	tools := prompttemplate.NewToolRegistry(calculatorTool{})
+
+	result, err := prompttemplate.RunAgent(client, agentModel, "You are a helpful assistant with access to tools.", userPrompt, tools, 5)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running agent: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("========================================")
+	fmt.Println("Final Answer")
+	fmt.Println("========================================\n")
+	fmt.Println(result.Answer)
+	fmt.Printf("\n(converged in %d step(s))\n", result.Steps)
+}
@@ -0,0 +1,76 @@
+package main
+
+/*
+Example 23: Streaming Chat Completions
+
+Demonstrates:
+- pkg/groqclient's StreamChatCompletion: plain content deltas over SSE,
+  the non-reasoning sibling of Example 13's CallReasoningStream
+- Printing tokens to stdout as they arrive instead of blocking on the
+  whole response
+- Aborting a stream mid-flight with context cancellation
+
+Why this matters: the main chat model alone takes ~500-2000ms to respond
+(see the performance table in 05_prompt_guard.go) before any tokens are
+visible at all with a blocking call. Streaming gets the first token on
+screen immediately and the rest as they're generated, which is the UX
+users notice first.
+
+Note: Uses the shared pkg/groqclient package instead of hand-rolled
+map[string]interface{} wire types. StreamChatCompletion now sets
+stream_options.include_usage itself, so the final chunk's Usage field
+below is always populated rather than depending on the backend's default.
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+func main() {
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		fmt.Println("Error: GROQ_API_KEY environment variable not set")
+		os.Exit(1)
+	}
+
+	client := groqclient.New(groqclient.GroqBaseURL, apiKey)
+
+	// A generous timeout, not a tight one: this cancels the whole request
+	// if the model stalls, it isn't meant to cut a normal response short.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	chunks, err := client.StreamChatCompletion(ctx, groqclient.ChatCompletionRequest{
+		Model: "meta-llama/llama-4-scout-17b-16e-instruct",
+		Messages: []groqclient.Message{
+			groqclient.NewTextMessage("user", "Write a three-sentence story about a robot learning to paint."),
+		},
+	})
+	if err != nil {
+		fmt.Printf("Error starting stream: %v\n", err)
+		os.Exit(1)
+	}
+
+	var usage *groqclient.Usage
+	for chunk := range chunks {
+		if chunk.Err != nil {
+			fmt.Printf("\nError during stream: %v\n", chunk.Err)
+			os.Exit(1)
+		}
+		fmt.Print(chunk.Delta)
+		if chunk.Usage != nil {
+			usage = chunk.Usage
+		}
+	}
+
+	fmt.Println()
+	if usage != nil {
+		fmt.Printf("\nTokens used: %d prompt + %d completion = %d total\n",
+			usage.PromptTokens, usage.CompletionTokens, usage.TotalTokens)
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+/*
+Example 24: Multi-Provider Router with Failover
+
+Demonstrates pkg/providers.Router: answering the same prompt through Groq
+as the primary provider with SambaNova as backup, falling back
+automatically on a retryable error (rate limit or server error) and
+printing which provider actually served the response - plus Stats() for
+each provider's request count, success rate, and latency.
+
+Prerequisites:
+  - GROQ_API_KEY environment variable set
+  - SAMBANOVA_API_KEY environment variable set
+
+Uses Go standard library only.
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/llm"
+	"github.com/patdeg/ai101/go/pkg/providers"
+)
+
+func main() {
+	groqKey := os.Getenv("GROQ_API_KEY")
+	if groqKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY environment variable not set")
+		os.Exit(1)
+	}
+	sambaKey := os.Getenv("SAMBANOVA_API_KEY")
+	if sambaKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: SAMBANOVA_API_KEY environment variable not set")
+		os.Exit(1)
+	}
+
+	router := providers.NewRouter(providers.PolicyFirstSuccess,
+		providers.Named{Name: "groq", Provider: llm.NewGroqProvider(groqKey)},
+		providers.Named{Name: "sambanova", Provider: llm.NewSambaNovaProvider(sambaKey)},
+	)
+
+	resp, servedBy, err := router.Chat(context.Background(), llm.ChatRequest{
+		Messages: []llm.Message{
+			{Role: "user", Content: "What is the capital of Switzerland?"},
+		},
+		MaxTokens: 100,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("========================================")
+	fmt.Println("Answer")
+	fmt.Println("========================================")
+	fmt.Printf("Served by: %s\n\n", servedBy)
+	fmt.Println(resp.Text)
+
+	fmt.Println("\n========================================")
+	fmt.Println("Stats")
+	fmt.Println("========================================")
+	for name, stats := range router.Stats() {
+		fmt.Printf("%s: requests=%d successes=%d failures=%d total_latency=%s total_tokens=%d\n",
+			name, stats.Requests, stats.Successes, stats.Failures, stats.TotalLatency, stats.TotalTokens)
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Command guardservice is a long-running HTTP guard service built on
+pkg/promptguard, for deployments that want a standing security sidecar
+instead of the one-shot CLI examples elsewhere in this repo.
+
+It serves POST /scan, gated by mutual TLS: both the server and every
+caller present a certificate signed by a shared CA, so only known
+clients can reach it.
+
+Note: the rest of this repo intentionally sticks to the Go standard
+library, so this service is HTTP, not gRPC — adding gRPC support would
+mean depending on google.golang.org/grpc and its protobuf toolchain,
+which is a bigger step than this educational repo takes elsewhere. The
+request/response shape below is deliberately close to a unary gRPC
+service (one request message, one response message) so that porting it
+to a real .proto later is mechanical.
+
+Usage:
+    guardservice \
+      --addr :8443 \
+      --server-cert server.crt --server-key server.key \
+      --client-ca ca.crt
+
+Environment:
+    GROQ_API_KEY - Your Groq API key
+*/
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/promptguard"
+)
+
+type scanRequest struct {
+	Message string `json:"message"`
+}
+
+type scanResponse struct {
+	Label string  `json:"label"`
+	Score float64 `json:"score"`
+	Block bool    `json:"block"`
+}
+
+func handleScan(pipeline *promptguard.Pipeline) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req scanRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid JSON body", http.StatusBadRequest)
+			return
+		}
+
+		verdict, err := pipeline.Run(req.Message)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("scan failed: %v", err), http.StatusBadGateway)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(scanResponse{
+			Label: string(verdict.Label),
+			Score: verdict.Score,
+			Block: verdict.IsAttack(),
+		})
+	}
+}
+
+// loadClientCAPool reads a PEM file of one or more CA certificates that are
+// allowed to sign client certificates presented to this server.
+func loadClientCAPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
+
+func main() {
+	addr := flag.String("addr", ":8443", "address to listen on")
+	serverCert := flag.String("server-cert", "", "path to the server's TLS certificate")
+	serverKey := flag.String("server-key", "", "path to the server's TLS private key")
+	clientCA := flag.String("client-ca", "", "path to the CA bundle used to verify client certificates")
+	flag.Parse()
+
+	if *serverCert == "" || *serverKey == "" || *clientCA == "" {
+		fmt.Fprintln(os.Stderr, "Error: --server-cert, --server-key, and --client-ca are all required")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY environment variable not set")
+		os.Exit(1)
+	}
+
+	clientCAs, err := loadClientCAPool(*clientCA)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	promptGuard := promptguard.New("https://api.groq.com/openai/v1", apiKey)
+	llamaGuard := promptguard.NewLlamaGuardStage("https://api.groq.com/openai/v1", apiKey)
+	pipeline := promptguard.NewPipeline(promptGuard, llamaGuard)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/scan", handleScan(pipeline))
+
+	server := &http.Server{
+		Addr:    *addr,
+		Handler: mux,
+		TLSConfig: &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+
+	fmt.Printf("guardservice listening on %s (mTLS required)\n", *addr)
+	if err := server.ListenAndServeTLS(*serverCert, *serverKey); err != nil {
+		fmt.Fprintf(os.Stderr, "Server error: %v\n", err)
+		os.Exit(1)
+	}
+}
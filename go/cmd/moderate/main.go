@@ -0,0 +1,70 @@
+/*
+Command moderate is a small CLI around pkg/moderation, for scripting a
+LlamaGuard safety check into a shell pipeline instead of wiring up
+pkg/moderation.Wrapper in Go.
+
+Usage:
+
+    moderate < message.txt
+    echo "some text" | moderate
+
+Reads the entire stdin as one message, runs it through a
+moderation.LlamaGuardModerator, and prints a JSON Verdict to stdout.
+Exits 0 regardless of the verdict (flagged or not) - exit status is
+reserved for a moderation check that itself failed, e.g. a network error.
+
+Environment:
+    GROQ_API_KEY - Your Groq API key
+*/
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/moderation"
+)
+
+type cliVerdict struct {
+	Flagged      bool     `json:"flagged"`
+	Categories   []string `json:"categories,omitempty"`
+	Descriptions []string `json:"descriptions,omitempty"`
+	RawResponse  string   `json:"raw_response"`
+}
+
+func main() {
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY environment variable not set")
+		os.Exit(1)
+	}
+
+	input, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading stdin: %v\n", err)
+		os.Exit(1)
+	}
+
+	moderator := moderation.NewLlamaGuardModerator("https://api.groq.com/openai/v1", apiKey)
+	verdict, err := moderator.Check(context.Background(), "user", string(input))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running moderation check: %v\n", err)
+		os.Exit(1)
+	}
+
+	out := cliVerdict{
+		Flagged:      verdict.Flagged,
+		Categories:   verdict.Categories,
+		Descriptions: verdict.CategoryDescriptions(),
+		RawResponse:  verdict.RawResponse,
+	}
+	encoded, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error encoding verdict: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(encoded))
+}
@@ -0,0 +1,202 @@
+/*
+Command rag-search combines Example 5 (Prompt Guard) and Example 8 (Tavily
+Search) into one grounded-search CLI: it screens the query, searches the
+web, and asks Groq to synthesize an answer with footnote citations back to
+the sources, instead of leaving callers to wire the two demos together
+themselves.
+
+Usage:
+
+    rag-search [--topic general|news|finance] [--days N]
+               [--include-domains a.com,b.com] [--exclude-domains c.com]
+               <query>
+
+Environment:
+    GROQ_API_KEY   - Your Groq API key
+    TAVILY_API_KEY - Your Tavily API key
+*/
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+	"github.com/patdeg/ai101/go/pkg/httpx"
+	"github.com/patdeg/ai101/go/pkg/promptguard"
+)
+
+const answerModel = "meta-llama/llama-4-scout-17b-16e-instruct"
+
+// tavilySearchRequest mirrors 08_tavily_search.go's TavilySearchRequest,
+// extended with the topic/time/domain filters this command exposes as
+// flags.
+type tavilySearchRequest struct {
+	Query           string   `json:"query"`
+	SearchDepth     string   `json:"search_depth"`
+	Topic           string   `json:"topic,omitempty"`
+	Days            int      `json:"days,omitempty"`
+	IncludeAnswer   bool     `json:"include_answer"`
+	MaxResults      int      `json:"max_results"`
+	IncludeDomains  []string `json:"include_domains,omitempty"`
+	ExcludeDomains  []string `json:"exclude_domains,omitempty"`
+}
+
+type tavilyResult struct {
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	Content string `json:"content"`
+}
+
+type tavilySearchResponse struct {
+	Answer  string         `json:"answer"`
+	Results []tavilyResult `json:"results"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// splitFlag turns a comma-separated flag value into a slice, or nil if the
+// flag was left empty.
+func splitFlag(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+func tavilySearch(apiKey string, req tavilySearchRequest) (tavilySearchResponse, error) {
+	var result tavilySearchResponse
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return result, fmt.Errorf("failed to marshal Tavily request: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, "https://api.tavily.com/search", bytes.NewReader(body))
+	if err != nil {
+		return result, fmt.Errorf("failed to build Tavily request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+
+	client, err := httpx.DefaultClient()
+	if err != nil {
+		return result, fmt.Errorf("failed to build HTTP client: %w", err)
+	}
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return result, fmt.Errorf("Tavily request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("failed to read Tavily response: %w", err)
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return result, fmt.Errorf("failed to parse Tavily response: %w", err)
+	}
+	if result.Error != "" {
+		return result, fmt.Errorf("Tavily error: %s", result.Error)
+	}
+	return result, nil
+}
+
+// groundedSystemPrompt builds the system message that grounds the model's
+// answer in results, numbering each one so the model can cite it as [n].
+func groundedSystemPrompt(results []tavilyResult) string {
+	var b strings.Builder
+	b.WriteString("Answer the user's question using only the search results below. ")
+	b.WriteString("Cite sources inline as [1], [2], etc. matching the numbering here.\n\n")
+	for i, r := range results {
+		fmt.Fprintf(&b, "[%d] %s\n%s\n%s\n\n", i+1, r.Title, r.URL, r.Content)
+	}
+	return b.String()
+}
+
+func main() {
+	topic := flag.String("topic", "general", "Tavily search topic: general, news, or finance")
+	days := flag.Int("days", 0, "restrict news results to the last N days (topic=news only)")
+	includeDomains := flag.String("include-domains", "", "comma-separated domains to restrict results to")
+	excludeDomains := flag.String("exclude-domains", "", "comma-separated domains to exclude from results")
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		fmt.Fprintln(os.Stderr, "Usage: rag-search [flags] <query>")
+		flag.Usage()
+		os.Exit(1)
+	}
+	query := strings.Join(flag.Args(), " ")
+
+	groqAPIKey := os.Getenv("GROQ_API_KEY")
+	if groqAPIKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: GROQ_API_KEY environment variable not set")
+		os.Exit(1)
+	}
+	tavilyAPIKey := os.Getenv("TAVILY_API_KEY")
+	if tavilyAPIKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: TAVILY_API_KEY environment variable not set")
+		os.Exit(1)
+	}
+
+	// Step 1: Prompt Guard the query before it goes anywhere near Tavily
+	// or Groq.
+	guard := promptguard.New(groqclient.GroqBaseURL, groqAPIKey)
+	verdict, err := guard.Scan(query)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error scanning query: %v\n", err)
+		os.Exit(1)
+	}
+	if verdict.IsAttack() {
+		fmt.Fprintf(os.Stderr, "Query blocked by Prompt Guard (score=%.3f)\n", verdict.Score)
+		os.Exit(1)
+	}
+
+	// Step 2: Search with Tavily.
+	searchResp, err := tavilySearch(tavilyAPIKey, tavilySearchRequest{
+		Query:          query,
+		SearchDepth:    "advanced",
+		Topic:          *topic,
+		Days:           *days,
+		IncludeAnswer:  true,
+		MaxResults:     5,
+		IncludeDomains: splitFlag(*includeDomains),
+		ExcludeDomains: splitFlag(*excludeDomains),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error searching: %v\n", err)
+		os.Exit(1)
+	}
+	if len(searchResp.Results) == 0 {
+		fmt.Println("No search results found.")
+		return
+	}
+
+	// Step 3: Ask Groq to synthesize an answer grounded in the results.
+	groq := groqclient.New(groqclient.GroqBaseURL, groqAPIKey)
+	chatResp, err := groq.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model: answerModel,
+		Messages: []groqclient.Message{
+			groqclient.NewTextMessage("system", groundedSystemPrompt(searchResp.Results)),
+			groqclient.NewTextMessage("user", query),
+		},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating answer: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 4: Print the synthesized answer and the footnote mapping.
+	fmt.Println(chatResp.Choices[0].Message.Content)
+	fmt.Println()
+	fmt.Println("Sources:")
+	for i, r := range searchResp.Results {
+		fmt.Printf("[%d] %s - %s\n", i+1, r.Title, r.URL)
+	}
+}
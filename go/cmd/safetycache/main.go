@@ -0,0 +1,137 @@
+/*
+Command safetycache is a small CLI around pkg/safetycache.
+
+Usage:
+
+    safetycache check [--force-refresh] <image-path>
+    safetycache stats
+
+"check" runs an image through the content-addressed safety cache, calling
+LlamaGuard only on a miss. "stats" reports the hit rate and estimated
+dollars saved for the current cache directory.
+
+Environment:
+    GROQ_API_KEY        - Your Groq API key
+    SAFETYCACHE_DIR     - Cache directory (default: ~/.ai101/safetycache)
+*/
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+	"github.com/patdeg/ai101/go/pkg/safetycache"
+)
+
+const safetyModel = "meta-llama/llama-guard-4-12b"
+
+func cacheDir() string {
+	if dir := os.Getenv("SAFETYCACHE_DIR"); dir != "" {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".ai101/safetycache"
+	}
+	return filepath.Join(home, ".ai101", "safetycache")
+}
+
+// callLlamaGuard is the real Checker backing the cache: one HTTP call per
+// miss, via the shared pkg/groqclient.
+func callLlamaGuard(imageData []byte, model string) (safetycache.SafetyResult, error) {
+	apiKey := os.Getenv("GROQ_API_KEY")
+	if apiKey == "" {
+		return safetycache.SafetyResult{}, fmt.Errorf("GROQ_API_KEY environment variable not set")
+	}
+
+	client := groqclient.New(groqclient.GroqBaseURL, apiKey)
+	resp, err := client.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model: model,
+		Messages: []groqclient.Message{
+			{Role: "user", Content: groqclient.ImageContentParts("Check this image for safety", imageData, "image/jpeg")},
+		},
+		MaxTokens: 100,
+	})
+	if err != nil {
+		return safetycache.SafetyResult{}, err
+	}
+
+	content := resp.Choices[0].Message.Content
+	isSafe := len(content) >= 4 && content[:4] == "safe"
+	return safetycache.SafetyResult{IsSafe: isSafe, Category: content, Explanation: content}, nil
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	switch os.Args[1] {
+	case "check":
+		runCheck(os.Args[2:])
+	case "stats":
+		runStats()
+	default:
+		usage()
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: safetycache check [--force-refresh] <image-path>")
+	fmt.Fprintln(os.Stderr, "       safetycache stats")
+}
+
+func runCheck(args []string) {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+	forceRefresh := fs.Bool("force-refresh", false, "bypass the cache and re-check the image")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		usage()
+		os.Exit(1)
+	}
+	imagePath := fs.Arg(0)
+
+	imageData, err := os.ReadFile(imagePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading image: %v\n", err)
+		os.Exit(1)
+	}
+
+	cache, err := safetycache.New(cacheDir(), safetyModel, 0, 1000, callLlamaGuard)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening cache: %v\n", err)
+		os.Exit(1)
+	}
+
+	result, err := cache.Check(imageData, *forceRefresh)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error checking image: %v\n", err)
+		os.Exit(1)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.Encode(result)
+}
+
+func runStats() {
+	cache, err := safetycache.New(cacheDir(), safetyModel, 0, 1000, callLlamaGuard)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening cache: %v\n", err)
+		os.Exit(1)
+	}
+
+	stats := cache.Stats()
+	fmt.Printf("Cache dir:        %s\n", cacheDir())
+	fmt.Printf("Hits:             %d (%d via perceptual match)\n", stats.Hits, stats.PerceptualHits)
+	fmt.Printf("Misses:           %d\n", stats.Misses)
+	fmt.Printf("Hit rate:         %.1f%%\n", stats.HitRatio()*100)
+	fmt.Printf("Est. saved:       $%.6f\n", cache.EstimatedSavings())
+}
@@ -0,0 +1,38 @@
+/*
+Package audit defines a pluggable AuditLogger that Example 1
+(01_basic_chat_UNIFIED.go) and the Council exercise (17_council_voting.go)
+route every request/response pair through, so an operator can persist AI
+call history - tokens, cost, persona votes, tool executions - without
+forking the examples. The active sink is chosen by the AUDIT_SINK
+environment variable (file|sqlite|grpc|stdout, see env.go), mirroring
+pkg/notify's FromEnv pattern for alert sinks.
+*/
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// Event is one audited request/response pair.
+type Event struct {
+	RequestID        string    `json:"request_id"`
+	Endpoint         string    `json:"endpoint"`
+	Timestamp        time.Time `json:"timestamp"`
+	PromptTokens     int       `json:"prompt_tokens"`
+	CompletionTokens int       `json:"completion_tokens"`
+	CostUSD          float64   `json:"cost_usd"`
+	// PersonaVotes holds one persona-name -> vote entry per Council call;
+	// nil for a plain chat call.
+	PersonaVotes map[string]string `json:"persona_votes,omitempty"`
+	// ToolExecutions names each tool the model invoked (e.g.
+	// "code_interpreter"), for code-execution calls; nil otherwise.
+	ToolExecutions []string `json:"tool_executions,omitempty"`
+}
+
+// AuditLogger persists Events to some backend. Implementations should be
+// safe for concurrent use, since a caller may log from multiple
+// in-flight requests at once.
+type AuditLogger interface {
+	Log(ctx context.Context, event Event) error
+}
@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+)
+
+// EnvAuditSink selects the active sink: file, sqlite, grpc, or stdout.
+const EnvAuditSink = "AUDIT_SINK"
+
+// EnvAuditFilePath overrides the file/sqlite sinks' default path.
+const EnvAuditFilePath = "AUDIT_FILE_PATH"
+
+// EnvAuditGRPCAddr sets the grpc sink's collector address (required when
+// AUDIT_SINK=grpc).
+const EnvAuditGRPCAddr = "AUDIT_GRPC_ADDR"
+
+// FromEnv builds the AuditLogger named by AUDIT_SINK, defaulting to
+// stdout if unset.
+func FromEnv() (AuditLogger, error) {
+	sink := os.Getenv(EnvAuditSink)
+	if sink == "" {
+		sink = "stdout"
+	}
+
+	switch sink {
+	case "stdout":
+		return NewStdoutLogger(), nil
+	case "file":
+		return NewFileLogger(filePathOrDefault("audit.jsonl"))
+	case "sqlite":
+		return NewSQLiteLogger(filePathOrDefault("audit.sqlite"))
+	case "grpc":
+		addr := os.Getenv(EnvAuditGRPCAddr)
+		if addr == "" {
+			return nil, fmt.Errorf("%s must be set when %s=grpc", EnvAuditGRPCAddr, EnvAuditSink)
+		}
+		return NewGRPCLogger(addr)
+	default:
+		return nil, fmt.Errorf("unknown %s %q (want file, sqlite, grpc, or stdout)", EnvAuditSink, sink)
+	}
+}
+
+func filePathOrDefault(def string) string {
+	if path := os.Getenv(EnvAuditFilePath); path != "" {
+		return path
+	}
+	return def
+}
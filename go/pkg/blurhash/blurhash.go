@@ -0,0 +1,192 @@
+/*
+Package blurhash implements the BlurHash encoding algorithm
+(https://blurha.sh): a compact string representation of a blurred preview
+of an image, short enough to embed alongside a URL or API response so a UI
+can paint a placeholder before the real image loads.
+
+This is a from-scratch implementation against the published algorithm, to
+keep the repo's Go examples free of third-party dependencies.
+*/
+package blurhash
+
+import (
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math"
+)
+
+// DecodeAndEncode decodes an image from r and returns its BlurHash, so
+// callers that already have an io.Reader (an *os.File, a bytes.Reader over
+// a freshly-downloaded image) don't need to decode it themselves first.
+func DecodeAndEncode(r io.Reader, componentsX, componentsY int) (string, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return "", fmt.Errorf("blurhash: failed to decode image: %w", err)
+	}
+	return Encode(img, componentsX, componentsY)
+}
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+// Encode computes the BlurHash string for img using componentsX x
+// componentsY DCT components (1-9 each; 4x3 is a typical default — more
+// components capture more detail at the cost of a longer string).
+func Encode(img image.Image, componentsX, componentsY int) (string, error) {
+	if componentsX < 1 || componentsX > 9 || componentsY < 1 || componentsY > 9 {
+		return "", fmt.Errorf("blurhash: componentsX and componentsY must be in [1,9], got %d, %d", componentsX, componentsY)
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return "", fmt.Errorf("blurhash: image has zero dimension")
+	}
+
+	factors := make([][3]float64, 0, componentsX*componentsY)
+	for j := 0; j < componentsY; j++ {
+		for i := 0; i < componentsX; i++ {
+			normalization := 1.0
+			if i != 0 || j != 0 {
+				normalization = 2.0
+			}
+			factors = append(factors, multiplyBasisFunction(img, bounds, i, j, normalization))
+		}
+	}
+
+	dc := factors[0]
+	ac := factors[1:]
+
+	var result []byte
+
+	sizeFlag := (componentsX - 1) + (componentsY-1)*9
+	result = append(result, encodeBase83(sizeFlag, 1)...)
+
+	var maximumValue float64
+	if len(ac) > 0 {
+		var actualMaximumValue float64
+		for _, f := range ac {
+			for _, v := range f {
+				if math.Abs(v) > actualMaximumValue {
+					actualMaximumValue = math.Abs(v)
+				}
+			}
+		}
+		quantizedMaximumValue := int(math.Max(0, math.Min(82, math.Floor(actualMaximumValue*166-0.5))))
+		maximumValue = float64(quantizedMaximumValue+1) / 166
+		result = append(result, encodeBase83(quantizedMaximumValue, 1)...)
+	} else {
+		maximumValue = 1
+		result = append(result, encodeBase83(0, 1)...)
+	}
+
+	result = append(result, encodeBase83(encodeDC(dc), 4)...)
+
+	for _, f := range ac {
+		result = append(result, encodeBase83(encodeAC(f, maximumValue), 2)...)
+	}
+
+	return string(result), nil
+}
+
+// multiplyBasisFunction averages img's linear-light RGB against the (i,j)
+// cosine basis function, the core of the DCT-like transform BlurHash uses.
+func multiplyBasisFunction(img image.Image, bounds image.Rectangle, i, j int, normalization float64) [3]float64 {
+	width, height := bounds.Dx(), bounds.Dy()
+	var r, g, b float64
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			basis := math.Cos(math.Pi*float64(i)*float64(x)/float64(width)) *
+				math.Cos(math.Pi*float64(j)*float64(y)/float64(height))
+
+			pr, pg, pb, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			r += basis * srgbToLinear(float64(pr>>8))
+			g += basis * srgbToLinear(float64(pg>>8))
+			b += basis * srgbToLinear(float64(pb>>8))
+		}
+	}
+
+	scale := normalization / float64(width*height)
+	return [3]float64{r * scale, g * scale, b * scale}
+}
+
+func srgbToLinear(value float64) float64 {
+	v := value / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSrgb(value float64) int {
+	v := math.Max(0, math.Min(1, value))
+	var srgb float64
+	if v <= 0.0031308 {
+		srgb = v * 12.92
+	} else {
+		srgb = 1.055*math.Pow(v, 1/2.4) - 0.055
+	}
+	return int(math.Round(srgb * 255))
+}
+
+// encodeDC packs the DC (average color) component into a 24-bit integer:
+// 8 bits each for sRGB red, green, and blue.
+func encodeDC(color [3]float64) int {
+	r := linearToSrgb(color[0])
+	g := linearToSrgb(color[1])
+	b := linearToSrgb(color[2])
+	return (r << 16) + (g << 8) + b
+}
+
+// encodeAC packs one AC component into a base-19 digit per channel
+// (19*19*19 = 6859, fitting 2 base83 characters), quantizing each channel
+// relative to maximumValue.
+func encodeAC(color [3]float64, maximumValue float64) int {
+	quantR := quantizeAC(color[0], maximumValue)
+	quantG := quantizeAC(color[1], maximumValue)
+	quantB := quantizeAC(color[2], maximumValue)
+	return quantR*19*19 + quantG*19 + quantB
+}
+
+func quantizeAC(value, maximumValue float64) int {
+	v := signPow(value/maximumValue, 0.5)
+	quant := int(math.Floor(v*9 + 9.5))
+	if quant < 0 {
+		return 0
+	}
+	if quant > 18 {
+		return 18
+	}
+	return quant
+}
+
+func signPow(value, exponent float64) float64 {
+	sign := 1.0
+	if value < 0 {
+		sign = -1.0
+	}
+	return sign * math.Pow(math.Abs(value), exponent)
+}
+
+// encodeBase83 encodes value as exactly length base83 digits, most
+// significant first.
+func encodeBase83(value, length int) []byte {
+	out := make([]byte, length)
+	for i := 1; i <= length; i++ {
+		digit := (value / pow83(length-i)) % 83
+		out[i-1] = base83Chars[digit]
+	}
+	return out
+}
+
+func pow83(exp int) int {
+	result := 1
+	for i := 0; i < exp; i++ {
+		result *= 83
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+/*
+Package completer selects an pkg/llm.Provider by name, defaulting to the
+DEFAULT_COMPLETER environment variable - mirroring the external llm-cli
+project's completer-selector pattern so examples can switch providers
+with an env var instead of a code change.
+
+It deliberately doesn't redefine request/response/provider types: pkg/llm
+already normalizes those across every backend this package selects among,
+so Completer is just an alias for llm.Provider and New's job is purely
+picking which constructor to call and which *_API_KEY to read.
+*/
+package completer
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/llm"
+)
+
+// EnvDefaultCompleter names the provider New builds when called with an
+// empty name.
+const EnvDefaultCompleter = "DEFAULT_COMPLETER"
+
+// Completer is an alias for llm.Provider - anything that can turn an
+// llm.ChatRequest into an llm.ChatResponse.
+type Completer = llm.Provider
+
+// New builds the Completer named by provider - one of "groq",
+// "demeterics", "openai", "anthropic", or "mistral" - reading its API key
+// from the matching *_API_KEY environment variable. If provider is empty,
+// New reads DEFAULT_COMPLETER instead, defaulting to "groq" if that's
+// unset too. It returns the resolved provider name alongside the
+// Completer so callers can report which one they got.
+func New(provider string) (Completer, string, error) {
+	if provider == "" {
+		provider = os.Getenv(EnvDefaultCompleter)
+	}
+	if provider == "" {
+		provider = "groq"
+	}
+
+	var build func(apiKey string) Completer
+	var envVar string
+
+	switch provider {
+	case "groq":
+		envVar, build = "GROQ_API_KEY", func(k string) Completer { return llm.NewGroqProvider(k) }
+	case "demeterics":
+		envVar, build = "DEMETERICS_API_KEY", func(k string) Completer { return llm.NewDemetericsProvider(k) }
+	case "openai":
+		envVar, build = "OPENAI_API_KEY", func(k string) Completer { return llm.NewOpenAIProvider(k) }
+	case "anthropic":
+		envVar, build = "ANTHROPIC_API_KEY", func(k string) Completer { return llm.NewAnthropicProvider(k) }
+	case "mistral":
+		envVar, build = "MISTRAL_API_KEY", func(k string) Completer { return llm.NewMistralProvider(k) }
+	default:
+		return nil, "", fmt.Errorf("unknown %s %q (want groq, demeterics, openai, anthropic, or mistral)", EnvDefaultCompleter, provider)
+	}
+
+	apiKey := os.Getenv(envVar)
+	if apiKey == "" {
+		return nil, "", fmt.Errorf("%s not set (required for %s=%s)", envVar, EnvDefaultCompleter, provider)
+	}
+	return build(apiKey), provider, nil
+}
@@ -0,0 +1,316 @@
+/*
+Package groqclient is a small typed client for the Groq / Demeterics chat
+completions API.
+
+It exists so the numbered examples in this repo don't each re-implement the
+same HTTP plumbing (endpoint URL, bearer auth, JSON marshaling, response
+parsing) with their own ad-hoc types. Examples that previously built
+map[string]interface{} requests by hand (14_reasoning.go) or defined their own
+ChatRequest/Message/ChatResponse structs (06_image_safety_check.go) should use
+Client instead.
+
+Uses Go standard library only.
+*/
+package groqclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/patdeg/ai101/go/pkg/httpx"
+)
+
+// HTTPError is returned by CreateChatCompletion when the server responds
+// with a non-2xx status, so a caller that needs to tell a rate limit
+// (429) or server error (5xx) apart from a malformed request (4xx) can
+// inspect StatusCode directly instead of pattern-matching an error
+// string - see pkg/providers.Router, which retries the next provider on
+// exactly those codes.
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("groq API request failed: status %d: %s", e.StatusCode, e.Body)
+}
+
+// Default API endpoints. Groq and Demeterics expose the same
+// OpenAI-compatible chat completions shape.
+const (
+	GroqBaseURL       = "https://api.groq.com/openai/v1"
+	DemetericsBaseURL = "https://api.demeterics.com/groq/v1"
+)
+
+// ReasoningFormat controls how a reasoning model's "thinking" is returned.
+type ReasoningFormat string
+
+const (
+	ReasoningFormatRaw    ReasoningFormat = "raw"
+	ReasoningFormatParsed ReasoningFormat = "parsed"
+	ReasoningFormatHidden ReasoningFormat = "hidden"
+)
+
+// ReasoningEffort controls how much a reasoning model is allowed to think.
+type ReasoningEffort string
+
+const (
+	ReasoningEffortLow    ReasoningEffort = "low"
+	ReasoningEffortMedium ReasoningEffort = "medium"
+	ReasoningEffortHigh   ReasoningEffort = "high"
+)
+
+// Client is a thin wrapper around an OpenAI-compatible chat completions
+// endpoint, authenticated with a bearer API key.
+type Client struct {
+	BaseURL    string
+	APIKey     string
+	HTTPClient *http.Client
+}
+
+// New creates a Client for baseURL, authenticating with apiKey. Its
+// HTTPClient comes from pkg/httpx, so setting GROQ_CLIENT_CERT,
+// GROQ_CLIENT_KEY, and GROQ_CA_BUNDLE enables mutual TLS for every example
+// that calls New without any other code change.
+func New(baseURL, apiKey string) *Client {
+	httpClient, err := httpx.DefaultClient()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: mTLS client setup failed, falling back to a plain HTTP client: %v\n", err)
+		httpClient = &http.Client{Timeout: httpx.DefaultTimeout}
+	}
+	return &Client{
+		BaseURL:    baseURL,
+		APIKey:     apiKey,
+		HTTPClient: httpClient,
+	}
+}
+
+// ContentPart is one piece of a multimodal message, e.g. a text span or an
+// image_url reference.
+type ContentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *ImageURL `json:"image_url,omitempty"`
+}
+
+// ImageURL carries an image as a remote URL or a base64 data URL.
+type ImageURL struct {
+	URL string `json:"url"`
+}
+
+// Message is a single chat turn. Content is either a plain string (the
+// common case) or a []ContentPart for multimodal messages; UnmarshalJSON
+// and MarshalJSON handle both shapes transparently. ToolCalls and
+// ToolCallID are only set for the assistant-requests-a-tool and
+// tool-returns-a-result turns of a function-calling loop.
+type Message struct {
+	Role       string `json:"role"`
+	Content    interface{}
+	ToolCalls  []ToolCall `json:"tool_calls,omitempty"`
+	ToolCallID string     `json:"tool_call_id,omitempty"`
+}
+
+// MarshalJSON emits Content verbatim, whether it is a string or a
+// []ContentPart slice.
+func (m Message) MarshalJSON() ([]byte, error) {
+	type wire struct {
+		Role       string      `json:"role"`
+		Content    interface{} `json:"content"`
+		ToolCalls  []ToolCall  `json:"tool_calls,omitempty"`
+		ToolCallID string      `json:"tool_call_id,omitempty"`
+	}
+	return json.Marshal(wire{Role: m.Role, Content: m.Content, ToolCalls: m.ToolCalls, ToolCallID: m.ToolCallID})
+}
+
+// UnmarshalJSON accepts either a string "content" field or an array of
+// content parts, storing Content as string or []ContentPart respectively.
+func (m *Message) UnmarshalJSON(data []byte) error {
+	var wire struct {
+		Role       string          `json:"role"`
+		Content    json.RawMessage `json:"content"`
+		ToolCalls  []ToolCall      `json:"tool_calls,omitempty"`
+		ToolCallID string          `json:"tool_call_id,omitempty"`
+	}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	m.Role = wire.Role
+	m.ToolCalls = wire.ToolCalls
+	m.ToolCallID = wire.ToolCallID
+
+	if len(wire.Content) == 0 {
+		m.Content = ""
+		return nil
+	}
+
+	var asString string
+	if err := json.Unmarshal(wire.Content, &asString); err == nil {
+		m.Content = asString
+		return nil
+	}
+
+	var asParts []ContentPart
+	if err := json.Unmarshal(wire.Content, &asParts); err != nil {
+		return fmt.Errorf("message content is neither a string nor a content-part array: %w", err)
+	}
+	m.Content = asParts
+	return nil
+}
+
+// NewTextMessage builds a Message with plain string content.
+func NewTextMessage(role, text string) Message {
+	return Message{Role: role, Content: text}
+}
+
+// NewToolResultMessage builds the "role": "tool" message that reports a
+// tool call's result back to the model, matched to its request by
+// toolCallID.
+func NewToolResultMessage(toolCallID, content string) Message {
+	return Message{Role: "tool", Content: content, ToolCallID: toolCallID}
+}
+
+// ToolDefinition describes one callable function in the shape the
+// /chat/completions "tools" field expects.
+type ToolDefinition struct {
+	Type     string             `json:"type"`
+	Function FunctionDefinition `json:"function"`
+}
+
+// FunctionDefinition is the "function" half of a ToolDefinition: its name,
+// a description the model uses to decide when to call it, and a
+// JSON-Schema object describing its arguments.
+type FunctionDefinition struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ToolCall is one function call the model requested inside an assistant
+// message.
+type ToolCall struct {
+	ID       string       `json:"id"`
+	Type     string       `json:"type"`
+	Function FunctionCall `json:"function"`
+}
+
+// FunctionCall carries a tool call's name and its arguments, JSON-encoded
+// as a string per the OpenAI-compatible wire format.
+type FunctionCall struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// ChatCompletionRequest mirrors the OpenAI-compatible /chat/completions
+// request body, including the reasoning fields Groq adds for reasoning
+// models such as openai/gpt-oss-20b.
+type ChatCompletionRequest struct {
+	Model               string           `json:"model"`
+	Messages            []Message        `json:"messages"`
+	Temperature         float64          `json:"temperature,omitempty"`
+	MaxTokens           int              `json:"max_tokens,omitempty"`
+	MaxCompletionTokens int              `json:"max_completion_tokens,omitempty"`
+	Stream              bool             `json:"stream,omitempty"`
+	StreamOptions       *StreamOptions   `json:"stream_options,omitempty"`
+	ReasoningFormat     ReasoningFormat  `json:"reasoning_format,omitempty"`
+	ReasoningEffort     ReasoningEffort  `json:"reasoning_effort,omitempty"`
+	Tools               []ToolDefinition `json:"tools,omitempty"`
+	// ToolChoice is "auto", "none", "required", or an object naming a
+	// specific tool - interface{} so callers can pass either shape
+	// straight through to the API.
+	ToolChoice interface{} `json:"tool_choice,omitempty"`
+}
+
+// StreamOptions controls what a streamed response includes beyond plain
+// content deltas.
+type StreamOptions struct {
+	// IncludeUsage asks the API to emit one extra chunk after the final
+	// content delta, carrying token usage for the whole request - without
+	// it, usage is only sent on non-streamed responses.
+	IncludeUsage bool `json:"include_usage,omitempty"`
+}
+
+// Choice is one completion choice in a ChatCompletionResponse.
+type Choice struct {
+	Index   int `json:"index"`
+	Message struct {
+		Role      string     `json:"role"`
+		Content   string     `json:"content"`
+		Reasoning string     `json:"reasoning,omitempty"`
+		ToolCalls []ToolCall `json:"tool_calls,omitempty"`
+	} `json:"message"`
+	FinishReason string `json:"finish_reason"`
+}
+
+// PromptTokensDetails breaks prompt_tokens down further, notably how many
+// of them were served from Groq's prompt cache.
+type PromptTokensDetails struct {
+	CachedTokens int `json:"cached_tokens"`
+}
+
+// Usage reports token consumption and cache utilization for one request.
+type Usage struct {
+	PromptTokens        int                  `json:"prompt_tokens"`
+	CompletionTokens    int                  `json:"completion_tokens"`
+	TotalTokens         int                  `json:"total_tokens"`
+	PromptTokensDetails *PromptTokensDetails `json:"prompt_tokens_details,omitempty"`
+}
+
+// ChatCompletionResponse mirrors the OpenAI-compatible /chat/completions
+// response body.
+type ChatCompletionResponse struct {
+	ID      string   `json:"id"`
+	Object  string   `json:"object"`
+	Created int64    `json:"created"`
+	Model   string   `json:"model"`
+	Choices []Choice `json:"choices"`
+	Usage   Usage    `json:"usage"`
+	Error   *struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+	} `json:"error,omitempty"`
+}
+
+// CreateChatCompletion sends req to the client's chat completions endpoint
+// and returns the parsed response.
+func (c *Client) CreateChatCompletion(req ChatCompletionRequest) (*ChatCompletionResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequest("POST", c.BaseURL+"/chat/completions", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.APIKey)
+
+	resp, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &HTTPError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	var result ChatCompletionResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("API error: %s", result.Error.Message)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,63 @@
+package groqclient
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ImageDataURL base64-encodes imageData once and returns it as a data: URL
+// for the given mimeType (e.g. "image/jpeg"). Callers that need to send the
+// same image to multiple models (a safety check followed by a vision call,
+// say) should decode/read the file once and pass the resulting []byte to
+// ImageDataURL for each call rather than re-reading the file.
+func ImageDataURL(imageData []byte, mimeType string) string {
+	return fmt.Sprintf("data:%s;base64,%s", mimeType, base64.StdEncoding.EncodeToString(imageData))
+}
+
+// ImageDataURLFromReader streams r straight into a base64 encoder instead
+// of reading it into a []byte first. For a call site that only needs the
+// image once (unlike the multi-stage reuse ImageDataURL is built for), this
+// avoids holding the full raw file and its base64 copy in memory at the
+// same time — io.Copy moves it through in small chunks.
+func ImageDataURLFromReader(r io.Reader, mimeType string) (string, error) {
+	var b strings.Builder
+	b.WriteString("data:")
+	b.WriteString(mimeType)
+	b.WriteString(";base64,")
+
+	enc := base64.NewEncoder(base64.StdEncoding, &b)
+	if _, err := io.Copy(enc, r); err != nil {
+		return "", fmt.Errorf("failed to stream image into base64 encoder: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("failed to flush base64 encoder: %w", err)
+	}
+	return b.String(), nil
+}
+
+// SniffImageMimeType detects an image's MIME type from its content ("magic
+// numbers" in the first bytes) rather than trusting a filename extension,
+// which can be missing, wrong, or simply absent when the data came from
+// somewhere other than a file. It falls back to "image/jpeg" for anything
+// http.DetectContentType doesn't recognize as one of the image types Groq
+// accepts.
+func SniffImageMimeType(data []byte) string {
+	switch detected := http.DetectContentType(data); detected {
+	case "image/png", "image/gif", "image/webp":
+		return detected
+	default:
+		return "image/jpeg"
+	}
+}
+
+// ImageContentParts builds the two-part ([text, image_url]) content slice
+// used by every vision/safety request in this repo.
+func ImageContentParts(prompt string, imageData []byte, mimeType string) []ContentPart {
+	return []ContentPart{
+		{Type: "text", Text: prompt},
+		{Type: "image_url", ImageURL: &ImageURL{URL: ImageDataURL(imageData, mimeType)}},
+	}
+}
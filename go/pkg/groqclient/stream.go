@@ -0,0 +1,227 @@
+package groqclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ReasoningEventType distinguishes the kind of delta carried by a
+// ReasoningEvent while streaming a reasoning model's response.
+type ReasoningEventType string
+
+const (
+	// EventReasoningDelta carries an incremental chunk of the model's
+	// "thinking" trace (reasoning_format: "parsed" only).
+	EventReasoningDelta ReasoningEventType = "reasoning_delta"
+	// EventContentDelta carries an incremental chunk of the final answer.
+	EventContentDelta ReasoningEventType = "content_delta"
+	// EventToolCall carries a tool call emitted by the model.
+	EventToolCall ReasoningEventType = "tool_call"
+	// EventUsage carries the final usage frame, sent once streaming ends.
+	EventUsage ReasoningEventType = "usage"
+	// EventDone signals the stream is finished; no further events follow.
+	EventDone ReasoningEventType = "done"
+)
+
+// ReasoningEvent is one item delivered on the channel returned by
+// CallReasoningStream.
+type ReasoningEvent struct {
+	Type      ReasoningEventType
+	Reasoning string // set for EventReasoningDelta
+	Content   string // set for EventContentDelta
+	ToolCall  json.RawMessage
+	Usage     *Usage // set for EventUsage
+	Err       error  // set if streaming failed; consumers should stop reading
+}
+
+// streamChunk mirrors one `data: {...}` line of an OpenAI-compatible
+// chat.completion.chunk SSE event.
+type streamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content   string          `json:"content"`
+			Reasoning string          `json:"reasoning"`
+			ToolCalls json.RawMessage `json:"tool_calls"`
+		} `json:"delta"`
+		FinishReason *string `json:"finish_reason"`
+	} `json:"choices"`
+	Usage *Usage `json:"usage"`
+}
+
+// CallReasoningStream issues req with stream:true and returns a channel of
+// ReasoningEvent as the response arrives. The channel is closed once the
+// stream ends (after an EventDone event, an event carrying Err, or the end
+// of the response body); an EventUsage event does not end the stream.
+// Callers typically range over the channel.
+func (c *Client) CallReasoningStream(req ChatCompletionRequest) (<-chan ReasoningEvent, error) {
+	req.Stream = true
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequest("POST", c.BaseURL+"/chat/completions", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.APIKey)
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	events := make(chan ReasoningEvent)
+	go func() {
+		defer resp.Body.Close()
+		defer close(events)
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload == "[DONE]" {
+				events <- ReasoningEvent{Type: EventDone}
+				return
+			}
+
+			var chunk streamChunk
+			if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+				events <- ReasoningEvent{Type: EventDone, Err: fmt.Errorf("failed to parse SSE chunk: %w", err)}
+				return
+			}
+
+			if chunk.Usage != nil {
+				events <- ReasoningEvent{Type: EventUsage, Usage: chunk.Usage}
+			}
+
+			for _, choice := range chunk.Choices {
+				if choice.Delta.Reasoning != "" {
+					events <- ReasoningEvent{Type: EventReasoningDelta, Reasoning: choice.Delta.Reasoning}
+				}
+				if choice.Delta.Content != "" {
+					events <- ReasoningEvent{Type: EventContentDelta, Content: choice.Delta.Content}
+				}
+				if len(choice.Delta.ToolCalls) > 0 {
+					events <- ReasoningEvent{Type: EventToolCall, ToolCall: choice.Delta.ToolCalls}
+				}
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			events <- ReasoningEvent{Type: EventDone, Err: fmt.Errorf("stream read failed: %w", err)}
+		}
+	}()
+
+	return events, nil
+}
+
+// ChatChunk is one incremental piece of a streamed chat completion, as
+// delivered by StreamChatCompletion. A zero FinishReason means the
+// response isn't finished yet; Usage is set only on the final chunk, since
+// that's the only one the API sends it on.
+type ChatChunk struct {
+	Delta        string
+	FinishReason string
+	Usage        *Usage
+	Err          error // set if streaming failed; consumers should stop reading
+}
+
+// StreamChatCompletion is CallReasoningStream's simpler sibling: it skips
+// reasoning/tool-call deltas and reports plain content chunks, and it
+// honors ctx so callers can abort the HTTP request mid-stream (e.g. a user
+// hitting Ctrl-C on a CLI, or a request deadline). The returned channel is
+// closed once the stream ends, after a final chunk carrying FinishReason,
+// Usage, or Err.
+func (c *Client) StreamChatCompletion(ctx context.Context, req ChatCompletionRequest) (<-chan ChatChunk, error) {
+	req.Stream = true
+	if req.StreamOptions == nil {
+		req.StreamOptions = &StreamOptions{IncludeUsage: true}
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.BaseURL+"/chat/completions", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.APIKey)
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	chunks := make(chan ChatChunk)
+	go func() {
+		defer resp.Body.Close()
+		defer close(chunks)
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+		for scanner.Scan() {
+			select {
+			case <-ctx.Done():
+				chunks <- ChatChunk{Err: ctx.Err()}
+				return
+			default:
+			}
+
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if payload == "[DONE]" {
+				return
+			}
+
+			var chunk streamChunk
+			if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+				chunks <- ChatChunk{Err: fmt.Errorf("failed to parse SSE chunk: %w", err)}
+				return
+			}
+
+			// The include_usage frame arrives with an empty choices array.
+			if len(chunk.Choices) == 0 && chunk.Usage != nil {
+				chunks <- ChatChunk{Usage: chunk.Usage}
+				continue
+			}
+
+			for _, choice := range chunk.Choices {
+				finishReason := ""
+				if choice.FinishReason != nil {
+					finishReason = *choice.FinishReason
+				}
+				if choice.Delta.Content != "" || finishReason != "" {
+					chunks <- ChatChunk{Delta: choice.Delta.Content, FinishReason: finishReason, Usage: chunk.Usage}
+				}
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			chunks <- ChatChunk{Err: fmt.Errorf("stream read failed: %w", err)}
+		}
+	}()
+
+	return chunks, nil
+}
@@ -0,0 +1,118 @@
+/*
+Package httpx builds the *http.Client this repo's outbound calls to Groq
+and Tavily use, optionally configured for mutual TLS against a corporate
+proxy.
+
+Enterprises fronting Groq/Tavily through a proxy that requires mutual TLS
+set GROQ_CLIENT_CERT, GROQ_CLIENT_KEY, and GROQ_CA_BUNDLE; Client then
+returns an *http.Client whose transport presents that certificate and
+trusts that CA bundle instead of the plain *http.Client{Timeout: ...}
+every example built by hand before this package existed. Leaving those env
+vars unset gets the same plain client, so this is a single, env-driven
+change that can enable mTLS across the whole module without touching any
+call site.
+*/
+package httpx
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read by Client and DefaultClient.
+const (
+	EnvClientCert        = "GROQ_CLIENT_CERT"
+	EnvClientKey         = "GROQ_CLIENT_KEY"
+	EnvCABundle          = "GROQ_CA_BUNDLE"
+	EnvRequestsPerMinute = "GROQ_RPM"
+)
+
+// DefaultTimeout is the timeout every Client-built *http.Client uses,
+// matching what groqclient.New used before this package existed.
+const DefaultTimeout = 60 * time.Second
+
+// Client returns an *http.Client for outbound API calls. If
+// EnvClientCert, EnvClientKey, and EnvCABundle are all set, the returned
+// client authenticates with mutual TLS using the referenced files; if none
+// of them are set, it returns a plain client with DefaultTimeout. Setting
+// only some of the three is an error, since a half-configured mTLS setup
+// would otherwise fail silently at the proxy.
+func Client() (*http.Client, error) {
+	certPath := os.Getenv(EnvClientCert)
+	keyPath := os.Getenv(EnvClientKey)
+	caPath := os.Getenv(EnvCABundle)
+
+	if certPath == "" && keyPath == "" && caPath == "" {
+		return &http.Client{Timeout: DefaultTimeout}, nil
+	}
+
+	tlsConfig, err := mTLSConfig(certPath, keyPath, caPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{
+		Timeout:   DefaultTimeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}, nil
+}
+
+// mTLSConfig builds a *tls.Config that presents the client certificate/key
+// pair at certPath/keyPath and trusts the CA bundle at caPath.
+func mTLSConfig(certPath, keyPath, caPath string) (*tls.Config, error) {
+	if certPath == "" || keyPath == "" || caPath == "" {
+		return nil, fmt.Errorf("%s, %s, and %s must all be set to enable mTLS", EnvClientCert, EnvClientKey, EnvCABundle)
+	}
+
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate/key: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %s", caPath)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+	}, nil
+}
+
+// DefaultClient is what every outbound Groq/Tavily call in this module
+// should use: Client()'s mTLS-aware *http.Client, wrapped in a
+// RetryTransport that retries 429/5xx responses with backoff. If
+// EnvRequestsPerMinute (GROQ_RPM) is set, it also pre-throttles requests
+// per host to that many requests per minute, so a burst of calls (e.g. a
+// loop of Prompt Guard checks) doesn't itself trigger the rate limits it's
+// trying to avoid.
+func DefaultClient() (*http.Client, error) {
+	client, err := Client()
+	if err != nil {
+		return nil, err
+	}
+
+	requestsPerMinute := 0
+	if raw := os.Getenv(EnvRequestsPerMinute); raw != "" {
+		requestsPerMinute, err = strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvRequestsPerMinute, err)
+		}
+	}
+
+	client.Transport = &RetryTransport{
+		Next:              client.Transport,
+		RequestsPerMinute: requestsPerMinute,
+	}
+	return client, nil
+}
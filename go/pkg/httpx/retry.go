@@ -0,0 +1,161 @@
+package httpx
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryTransport wraps another http.RoundTripper, retrying HTTP 429 and
+// 5xx responses with exponential backoff and full jitter, honoring any
+// Retry-After header the server sends, and enforcing a per-host
+// token-bucket rate limit so a burst of calls doesn't trigger those
+// responses in the first place.
+type RetryTransport struct {
+	// Next is the wrapped transport. http.DefaultTransport is used if nil.
+	Next http.RoundTripper
+	// MaxRetries is how many additional attempts a request gets after its
+	// first failure. Defaults to 5.
+	MaxRetries int
+	// Budget caps the total time spent retrying one request, across every
+	// attempt. Zero means no cap.
+	Budget time.Duration
+	// RequestsPerMinute, if non-zero, pre-throttles outgoing requests to
+	// at most this many per host before they're even sent - set it to
+	// your Groq/Tavily tier's RPM limit to stay under it proactively.
+	RequestsPerMinute int
+
+	mu       sync.Mutex
+	limiters map[string]*tokenBucket
+}
+
+func (t *RetryTransport) next() http.RoundTripper {
+	if t.Next != nil {
+		return t.Next
+	}
+	return http.DefaultTransport
+}
+
+func (t *RetryTransport) maxRetries() int {
+	if t.MaxRetries > 0 {
+		return t.MaxRetries
+	}
+	return 5
+}
+
+func (t *RetryTransport) limiterFor(host string) *tokenBucket {
+	if t.RequestsPerMinute <= 0 {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.limiters == nil {
+		t.limiters = make(map[string]*tokenBucket)
+	}
+	b, ok := t.limiters[host]
+	if !ok {
+		b = newTokenBucket(t.RequestsPerMinute)
+		t.limiters[host] = b
+	}
+	return b
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if limiter := t.limiterFor(req.URL.Host); limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Buffer the body up front so it can be replayed on every retry -
+	// req.Body is only readable once otherwise.
+	var bodyBytes []byte
+	if req.Body != nil {
+		var err error
+		bodyBytes, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to buffer request body for retries: %w", err)
+		}
+	}
+
+	var deadline time.Time
+	if t.Budget > 0 {
+		deadline = time.Now().Add(t.Budget)
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		if bodyBytes != nil {
+			req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		}
+
+		resp, err = t.next().RoundTrip(req)
+		if err == nil && !shouldRetry(resp.StatusCode) {
+			return resp, nil
+		}
+		if attempt >= t.maxRetries() {
+			return resp, err
+		}
+
+		wait := retryDelay(resp, attempt)
+		if !deadline.IsZero() && time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether status is worth retrying: rate-limited or a
+// server-side failure, never a client error like 400 or 401.
+func shouldRetry(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// retryDelay computes how long to wait before the next attempt: the
+// server's Retry-After header if present, else exponential backoff with
+// full jitter (the strategy AWS's architecture blog recommends to avoid a
+// thundering herd of retries all landing at once).
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+	const base = 500 * time.Millisecond
+	maxDelay := time.Duration(math.Pow(2, float64(attempt))) * base
+	return time.Duration(rand.Int63n(int64(maxDelay) + 1))
+}
+
+// parseRetryAfter parses a Retry-After header in either delta-seconds or
+// HTTP-date form, per RFC 7231 section 7.1.3.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		return time.Until(when), true
+	}
+	return 0, false
+}
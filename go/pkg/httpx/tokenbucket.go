@@ -0,0 +1,67 @@
+package httpx
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a thread-safe token bucket: tokens refill continuously at
+// ratePerMinute/60 tokens per second, up to a capacity equal to
+// ratePerMinute, so a caller can burst through a full minute's unused
+// allowance before settling into the steady rate.
+type tokenBucket struct {
+	mu         sync.Mutex
+	capacity   float64
+	tokens     float64
+	refillRate float64 // tokens per second
+	lastRefill time.Time
+}
+
+func newTokenBucket(ratePerMinute int) *tokenBucket {
+	capacity := float64(ratePerMinute)
+	return &tokenBucket{
+		capacity:   capacity,
+		tokens:     capacity,
+		refillRate: capacity / 60,
+		lastRefill: time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or ctx is canceled.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	for {
+		wait := b.reserve()
+		if wait <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve refills the bucket for elapsed time and either consumes a token
+// (returning 0, meaning proceed now) or reports how long the caller must
+// wait for one.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	b.tokens = math.Min(b.capacity, b.tokens+elapsed*b.refillRate)
+	b.lastRefill = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	deficit := 1 - b.tokens
+	return time.Duration(deficit / b.refillRate * float64(time.Second))
+}
@@ -0,0 +1,309 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/httpx"
+)
+
+// AnthropicBaseURL is Anthropic's Messages API endpoint.
+const AnthropicBaseURL = "https://api.anthropic.com/v1"
+
+const anthropicVersion = "2023-06-01"
+
+// DefaultAnthropicModel is used when a ChatRequest doesn't set Model.
+const DefaultAnthropicModel = "claude-haiku-4-5"
+
+// AnthropicProvider talks to Anthropic's Messages API.
+//
+// Raw HTTP shape, for comparison with OpenAIProvider's:
+//
+//	POST https://api.anthropic.com/v1/messages
+//	Headers: x-api-key, anthropic-version, Content-Type: application/json
+//	Body:    {"model","messages":[{"role","content"}],"system","max_tokens"}
+//	Reply:   {"content":[{"type":"text","text"}],"stop_reason",
+//	          "usage":{"input_tokens","output_tokens"}}
+type AnthropicProvider struct {
+	APIKey     string
+	HTTPClient *http.Client
+}
+
+// NewAnthropicProvider builds a Provider authenticated with apiKey.
+func NewAnthropicProvider(apiKey string) *AnthropicProvider {
+	httpClient, err := httpx.DefaultClient()
+	if err != nil {
+		httpClient = &http.Client{Timeout: httpx.DefaultTimeout}
+	}
+	return &AnthropicProvider{APIKey: apiKey, HTTPClient: httpClient}
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicRequest struct {
+	Model       string             `json:"model"`
+	System      string             `json:"system,omitempty"`
+	Messages    []anthropicMessage `json:"messages"`
+	Temperature float64            `json:"temperature,omitempty"`
+	MaxTokens   int                `json:"max_tokens"`
+}
+
+type anthropicContentBlock struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type anthropicUsage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+type anthropicResponse struct {
+	Content    []anthropicContentBlock `json:"content"`
+	StopReason string                  `json:"stop_reason"`
+	Usage      anthropicUsage          `json:"usage"`
+	Error      *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// Chat implements Provider. req.Tools/ToolChoice/Functions are ignored:
+// Anthropic's tool-use wire shape is content blocks on the message
+// itself (a "tool_use" block in the reply, a "tool_result" block in the
+// next request), not the separate tools/tool_calls array OpenAIProvider
+// translates - different enough that bridging it needs its own content-
+// block-aware request/response path, which this provider doesn't have
+// yet. Use OpenAIProvider (Groq/Demeterics/OpenAI/Mistral) for tool
+// calling today; see 10b_tool_use_typed.go.
+func (p *AnthropicProvider) Chat(ctx context.Context, req ChatRequest) (ChatResponse, error) {
+	model := req.Model
+	if model == "" {
+		model = DefaultAnthropicModel
+	}
+	maxTokens := req.MaxTokens
+	if maxTokens == 0 {
+		maxTokens = 1024
+	}
+
+	messages := make([]anthropicMessage, len(req.Messages))
+	for i, m := range req.Messages {
+		messages[i] = anthropicMessage{Role: m.Role, Content: m.Content}
+	}
+
+	body, err := json.Marshal(anthropicRequest{
+		Model:       model,
+		System:      req.System,
+		Messages:    messages,
+		Temperature: req.Temperature,
+		MaxTokens:   maxTokens,
+	})
+	if err != nil {
+		return ChatResponse{}, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, AnthropicBaseURL+"/messages", bytes.NewReader(body))
+	if err != nil {
+		return ChatResponse{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", p.APIKey)
+	httpReq.Header.Set("anthropic-version", anthropicVersion)
+
+	resp, err := p.HTTPClient.Do(httpReq)
+	if err != nil {
+		return ChatResponse{}, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ChatResponse{}, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var result anthropicResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return ChatResponse{}, fmt.Errorf("failed to parse response: %w (raw: %s)", err, respBody)
+	}
+	if result.Error != nil {
+		return ChatResponse{}, fmt.Errorf("Anthropic API error: %s", result.Error.Message)
+	}
+
+	var text string
+	if len(result.Content) > 0 {
+		text = result.Content[0].Text
+	}
+
+	return ChatResponse{
+		Text:         text,
+		FinishReason: result.StopReason,
+		Usage: Usage{
+			Input:  result.Usage.InputTokens,
+			Output: result.Usage.OutputTokens,
+			Total:  result.Usage.InputTokens + result.Usage.OutputTokens,
+		},
+	}, nil
+}
+
+// anthropicSSEEvent covers the fields used across every Anthropic
+// streaming event type this package reads; only the fields relevant to
+// a given Type are populated. See 01b_stream_anthropic.go for the same
+// shape spelled out over a worked example.
+type anthropicSSEEvent struct {
+	Type    string `json:"type"`
+	Message struct {
+		Usage anthropicUsage `json:"usage"`
+	} `json:"message"`
+	Delta struct {
+		Text       string `json:"text"`
+		StopReason string `json:"stop_reason"`
+	} `json:"delta"`
+	Usage anthropicUsage `json:"usage"`
+}
+
+// Stream implements StreamProvider. Anthropic's SSE frames are
+// blank-line-delimited blocks of "event: TYPE"/"data: {...}" lines
+// (unlike the OpenAI-shaped providers' one-bare-"data:"-line-per-chunk
+// convention handled by OpenAIProvider.Stream); splitAnthropicSSE below
+// breaks the stream on those blank lines instead of scanning line by
+// line.
+func (p *AnthropicProvider) Stream(ctx context.Context, req ChatRequest) (<-chan ChatChunk, error) {
+	model := req.Model
+	if model == "" {
+		model = DefaultAnthropicModel
+	}
+	maxTokens := req.MaxTokens
+	if maxTokens == 0 {
+		maxTokens = 1024
+	}
+
+	messages := make([]anthropicMessage, len(req.Messages))
+	for i, m := range req.Messages {
+		messages[i] = anthropicMessage{Role: m.Role, Content: m.Content}
+	}
+
+	body, err := json.Marshal(struct {
+		anthropicRequest
+		Stream bool `json:"stream"`
+	}{
+		anthropicRequest: anthropicRequest{
+			Model:       model,
+			System:      req.System,
+			Messages:    messages,
+			Temperature: req.Temperature,
+			MaxTokens:   maxTokens,
+		},
+		Stream: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, AnthropicBaseURL+"/messages", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", p.APIKey)
+	httpReq.Header.Set("anthropic-version", anthropicVersion)
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	resp, err := p.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	chunks := make(chan ChatChunk)
+	go func() {
+		defer resp.Body.Close()
+		defer close(chunks)
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		scanner.Split(splitAnthropicSSE)
+
+		var usage anthropicUsage
+		for scanner.Scan() {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			event, err := parseAnthropicSSE(scanner.Bytes())
+			if err != nil {
+				chunks <- ChatChunk{Err: err}
+				return
+			}
+			if event == nil {
+				continue // keep-alive or a line we don't need
+			}
+
+			switch event.Type {
+			case "message_start":
+				usage = event.Message.Usage
+			case "content_block_delta":
+				if event.Delta.Text != "" {
+					chunks <- ChatChunk{Delta: event.Delta.Text}
+				}
+			case "message_delta":
+				usage.OutputTokens = event.Usage.OutputTokens
+				if event.Delta.StopReason != "" {
+					chunks <- ChatChunk{
+						FinishReason: event.Delta.StopReason,
+						Usage: &Usage{
+							Input:  usage.InputTokens,
+							Output: usage.OutputTokens,
+							Total:  usage.InputTokens + usage.OutputTokens,
+						},
+					}
+				}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			chunks <- ChatChunk{Err: fmt.Errorf("stream read failed: %w", err)}
+		}
+	}()
+
+	return chunks, nil
+}
+
+// splitAnthropicSSE is a bufio.SplitFunc that breaks Anthropic's SSE
+// stream into blank-line-delimited event blocks instead of individual
+// lines, mirroring 01b_stream_anthropic.go's splitSSEEvents.
+func splitAnthropicSSE(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if i := bytes.Index(data, []byte("\n\n")); i >= 0 {
+		return i + 2, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// parseAnthropicSSE extracts the "data: {...}" line from one event block
+// and unmarshals it, returning a nil event (and nil error) for a block
+// that carries no data line (SSE comments/keep-alives).
+func parseAnthropicSSE(block []byte) (*anthropicSSEEvent, error) {
+	for _, line := range strings.Split(string(block), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		var event anthropicSSEEvent
+		if err := json.Unmarshal([]byte(payload), &event); err != nil {
+			return nil, fmt.Errorf("failed to parse SSE data: %w (raw: %s)", err, payload)
+		}
+		return &event, nil
+	}
+	return nil, nil
+}
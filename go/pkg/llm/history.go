@@ -0,0 +1,91 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// DefaultHistoryPath is where LoadHistory and History.Save read/write by
+// default: ~/.ai101/history.json, so a single conversation can be carried
+// across providers between invocations instead of each example starting
+// from a blank slate.
+func DefaultHistoryPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate home directory: %w", err)
+	}
+	return filepath.Join(home, ".ai101", "history.json"), nil
+}
+
+// HistoryTurn is one request/response pair recorded by History.Append.
+type HistoryTurn struct {
+	Provider string
+	Request  ChatRequest
+	Response ChatResponse
+}
+
+// History is a conversation's turns, persisted as one JSON array so a
+// user can resume it from a different example or provider later.
+type History struct {
+	Turns []HistoryTurn
+}
+
+// LoadHistory reads the History at path, returning an empty History (not
+// an error) if path doesn't exist yet - the common case for a first run.
+func LoadHistory(path string) (*History, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &History{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history %s: %w", path, err)
+	}
+
+	var h History
+	if err := json.Unmarshal(data, &h); err != nil {
+		return nil, fmt.Errorf("failed to parse history %s: %w", path, err)
+	}
+	return &h, nil
+}
+
+// Append records one turn. It doesn't persist anything by itself; call
+// Save to write the updated History back to disk.
+func (h *History) Append(provider string, req ChatRequest, resp ChatResponse) {
+	h.Turns = append(h.Turns, HistoryTurn{Provider: provider, Request: req, Response: resp})
+}
+
+// Messages returns the full conversation so far, so a caller can seed a
+// new ChatRequest.Messages with it. Only the last turn needs reading: its
+// Request.Messages is itself the previous turn's Messages (callers build
+// each new request as append(history.Messages(), newMsg), then Append
+// records that whole request), so it already carries every earlier turn -
+// appending its own Response is all that's needed to bring it up to date.
+func (h *History) Messages() []Message {
+	if len(h.Turns) == 0 {
+		return nil
+	}
+	last := h.Turns[len(h.Turns)-1]
+	messages := make([]Message, 0, len(last.Request.Messages)+1)
+	messages = append(messages, last.Request.Messages...)
+	messages = append(messages, Message{Role: "assistant", Content: last.Response.Text})
+	return messages
+}
+
+// Save writes h to path as indented JSON, creating path's parent
+// directory if needed.
+func (h *History) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal history: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write history %s: %w", path, err)
+	}
+	return nil
+}
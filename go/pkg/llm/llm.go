@@ -0,0 +1,151 @@
+/*
+Package llm normalizes chat completions across Anthropic's Messages API
+and the OpenAI-compatible /chat/completions shape (Groq, Demeterics,
+OpenAI itself) behind one Provider interface, so a caller can swap
+providers by changing a single line instead of rewriting request/response
+handling per provider.
+
+It is not a replacement for the numbered 01_basic_chat_*.go examples,
+which exist specifically to show each provider's raw wire format side by
+side for learning purposes; see 01_basic_chat_UNIFIED.go for this
+package in use, and AnthropicProvider's and OpenAIProvider's doc comments
+for the raw shapes they normalize.
+*/
+package llm
+
+import "context"
+
+// Message is one turn of conversation, provider-agnostic. ToolCalls is
+// set on an assistant message that requested tool calls; ToolCallID is
+// set on the "tool" message reporting one of those calls' result back
+// (see NormalizeTools and ChatResponse.ToolCalls).
+type Message struct {
+	Role       string // "user", "assistant", "system", or "tool"
+	Content    string
+	ToolCalls  []ToolCall
+	ToolCallID string
+}
+
+// FunctionDef is a tool's callable signature: a name and description the
+// model uses to decide when to call it, plus a JSON Schema object
+// describing its arguments.
+type FunctionDef struct {
+	Name        string
+	Description string
+	Parameters  map[string]interface{}
+}
+
+// Tool is one entry in ChatRequest.Tools, matching OpenAI's current
+// tools schema: {"type":"function","function":{...}}.
+type Tool struct {
+	Type     string // "function"
+	Function FunctionDef
+}
+
+// Function is the legacy pre-Tools function-calling surface OpenAI still
+// accepts for backward compatibility. NormalizeTools auto-wraps these
+// into Tools for providers that only understand the newer array - the
+// same forward-compat shim LocalAI adopted.
+type Function = FunctionDef
+
+// ToolCall is one function call, either requested by the model
+// (ChatResponse.ToolCalls / an assistant Message.ToolCalls) or being
+// reported back via a "tool" Message.
+type ToolCall struct {
+	ID       string
+	Type     string // "function"
+	Function ToolCallFunction
+}
+
+// ToolCallFunction carries a tool call's name and its arguments,
+// JSON-encoded as a string per the OpenAI-compatible wire format.
+type ToolCallFunction struct {
+	Name      string
+	Arguments string
+}
+
+// ChatRequest is a provider-agnostic chat completion request.
+type ChatRequest struct {
+	Model    string // defaults to the provider's own default model if empty
+	System   string // system prompt; Anthropic takes this as a top-level field, OpenAI-shaped providers get it prepended as a "system" message
+	Messages []Message
+
+	Temperature float64
+	// MaxTokens is required by Anthropic and optional elsewhere;
+	// AnthropicProvider defaults it to 1024 when unset.
+	MaxTokens int
+
+	// Tools are the tools a provider may call, in the current
+	// "type":"function" schema. Use NormalizeTools to read this field so
+	// Functions-only callers still work.
+	Tools []Tool
+	// ToolChoice controls whether/which tool the model must call: "auto",
+	// "none", "required", or an object naming a specific tool - passed
+	// through to the provider as-is.
+	ToolChoice interface{}
+	// Functions is the legacy surface NormalizeTools wraps into Tools
+	// when Tools is empty.
+	Functions []Function
+}
+
+// NormalizeTools returns req.Tools, auto-wrapping req.Functions into
+// Tools entries when Tools is empty, so a caller built against the
+// legacy Functions surface still gets tool calls from a provider that
+// only accepts the newer Tools array.
+func NormalizeTools(req ChatRequest) []Tool {
+	if len(req.Tools) > 0 || len(req.Functions) == 0 {
+		return req.Tools
+	}
+	tools := make([]Tool, len(req.Functions))
+	for i, fn := range req.Functions {
+		tools[i] = Tool{Type: "function", Function: fn}
+	}
+	return tools
+}
+
+// Usage reports token consumption, normalized across providers' differing
+// field names (input_tokens/output_tokens vs. prompt_tokens/completion_tokens).
+type Usage struct {
+	Input  int
+	Output int
+	Total  int
+}
+
+// ChatResponse is a provider-agnostic chat completion result. ToolCalls
+// is set instead of (or alongside) Text when the model wants a tool
+// run before it can finish answering.
+type ChatResponse struct {
+	Text         string
+	FinishReason string
+	Usage        Usage
+	ToolCalls    []ToolCall
+}
+
+// Provider turns a ChatRequest into a ChatResponse against one LLM
+// backend. AnthropicProvider and OpenAIProvider are the two
+// implementations; NewGroqProvider and NewDemetericsProvider are
+// OpenAIProvider configured with a different BaseURL and default model.
+type Provider interface {
+	Chat(ctx context.Context, req ChatRequest) (ChatResponse, error)
+}
+
+// ChatChunk is one incremental piece of a streamed ChatRequest, as
+// delivered by StreamProvider.Stream. A zero FinishReason means the
+// response isn't finished yet; Usage is set only on the final chunk,
+// since that's the only one either provider sends it on.
+type ChatChunk struct {
+	Delta        string
+	FinishReason string
+	Usage        *Usage
+	Err          error // set if streaming failed; consumers should stop reading
+}
+
+// StreamProvider is the streaming half of Provider, implemented by
+// AnthropicProvider and OpenAIProvider alongside Chat. It's a separate
+// interface rather than a second Provider method so a caller that only
+// needs Chat (most of this repo) isn't forced to depend on streaming
+// support existing for every backend.
+type StreamProvider interface {
+	Provider
+	Stream(ctx context.Context, req ChatRequest) (<-chan ChatChunk, error)
+}
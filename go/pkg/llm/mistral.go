@@ -0,0 +1,14 @@
+package llm
+
+// MistralBaseURL is Mistral's OpenAI-compatible chat completions endpoint.
+const MistralBaseURL = "https://api.mistral.ai/v1"
+
+// DefaultMistralModel is used when a ChatRequest doesn't set Model.
+const DefaultMistralModel = "mistral-small-latest"
+
+// NewMistralProvider builds a Provider for Mistral's OpenAI-compatible
+// endpoint. Like Groq and Demeterics, it's just an OpenAIProvider with a
+// different BaseURL and default model.
+func NewMistralProvider(apiKey string) *OpenAIProvider {
+	return newChatCompletionsProvider(MistralBaseURL, apiKey, DefaultMistralModel)
+}
@@ -0,0 +1,213 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+// OpenAIBaseURL is OpenAI's own chat completions endpoint.
+const OpenAIBaseURL = "https://api.openai.com/v1"
+
+// Default models used when a ChatRequest doesn't set Model.
+const (
+	DefaultOpenAIModel = "gpt-4o-mini"
+	DefaultGroqModel   = "meta-llama/llama-4-scout-17b-16e-instruct"
+)
+
+// OpenAIProvider adapts pkg/groqclient.Client - already an OpenAI-
+// compatible /chat/completions client - to the Provider interface. Groq
+// and Demeterics serve the same shape with a different BaseURL and
+// default model, so NewGroqProvider and NewDemetericsProvider below just
+// bake those defaults into an OpenAIProvider.
+//
+// Raw HTTP shape, for comparison with AnthropicProvider's:
+//
+//	POST {BaseURL}/chat/completions
+//	Headers: Authorization: Bearer, Content-Type: application/json
+//	Body:    {"model","messages":[{"role","content"}],"temperature","max_tokens"}
+//	Reply:   {"choices":[{"message":{"role","content"},"finish_reason"}],
+//	          "usage":{"prompt_tokens","completion_tokens","total_tokens"}}
+type OpenAIProvider struct {
+	client       *groqclient.Client
+	defaultModel string
+}
+
+// NewOpenAIProvider builds a Provider for OpenAI's own endpoint.
+func NewOpenAIProvider(apiKey string) *OpenAIProvider {
+	return newChatCompletionsProvider(OpenAIBaseURL, apiKey, DefaultOpenAIModel)
+}
+
+// NewGroqProvider builds a Provider for Groq's OpenAI-compatible endpoint.
+func NewGroqProvider(apiKey string) *OpenAIProvider {
+	return newChatCompletionsProvider(groqclient.GroqBaseURL, apiKey, DefaultGroqModel)
+}
+
+// NewDemetericsProvider builds a Provider for Demeterics' OpenAI-compatible endpoint.
+func NewDemetericsProvider(apiKey string) *OpenAIProvider {
+	return newChatCompletionsProvider(groqclient.DemetericsBaseURL, apiKey, DefaultGroqModel)
+}
+
+// SambaNovaBaseURL is SambaNova's OpenAI-compatible chat completions endpoint.
+const SambaNovaBaseURL = "https://api.sambanova.ai/v1"
+
+// DefaultSambaNovaModel is the model 01_basic_chat_SAMBA.go and its
+// streaming sibling use.
+const DefaultSambaNovaModel = "Meta-Llama-3.1-8B-Instruct"
+
+// NewSambaNovaProvider builds a Provider for SambaNova's OpenAI-compatible endpoint.
+func NewSambaNovaProvider(apiKey string) *OpenAIProvider {
+	return newChatCompletionsProvider(SambaNovaBaseURL, apiKey, DefaultSambaNovaModel)
+}
+
+func newChatCompletionsProvider(baseURL, apiKey, defaultModel string) *OpenAIProvider {
+	return &OpenAIProvider{client: groqclient.New(baseURL, apiKey), defaultModel: defaultModel}
+}
+
+// Chat implements Provider.
+func (p *OpenAIProvider) Chat(ctx context.Context, req ChatRequest) (ChatResponse, error) {
+	model := req.Model
+	if model == "" {
+		model = p.defaultModel
+	}
+
+	resp, err := p.client.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model:       model,
+		Messages:    toGroqMessages(req),
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+		Tools:       toGroqTools(NormalizeTools(req)),
+		ToolChoice:  req.ToolChoice,
+	})
+	if err != nil {
+		return ChatResponse{}, err
+	}
+	if len(resp.Choices) == 0 {
+		return ChatResponse{}, fmt.Errorf("no choices returned")
+	}
+
+	return ChatResponse{
+		Text:         resp.Choices[0].Message.Content,
+		FinishReason: resp.Choices[0].FinishReason,
+		ToolCalls:    fromGroqToolCalls(resp.Choices[0].Message.ToolCalls),
+		Usage: Usage{
+			Input:  resp.Usage.PromptTokens,
+			Output: resp.Usage.CompletionTokens,
+			Total:  resp.Usage.TotalTokens,
+		},
+	}, nil
+}
+
+// toGroqMessages converts req's provider-agnostic messages (plus its
+// System prompt, prepended as a "system" message) into groqclient's wire
+// shape, carrying ToolCalls/ToolCallID through for a tool-calling loop's
+// assistant and "tool" turns.
+func toGroqMessages(req ChatRequest) []groqclient.Message {
+	messages := make([]groqclient.Message, 0, len(req.Messages)+1)
+	if req.System != "" {
+		messages = append(messages, groqclient.Message{Role: "system", Content: req.System})
+	}
+	for _, m := range req.Messages {
+		messages = append(messages, groqclient.Message{
+			Role:       m.Role,
+			Content:    m.Content,
+			ToolCalls:  toGroqToolCalls(m.ToolCalls),
+			ToolCallID: m.ToolCallID,
+		})
+	}
+	return messages
+}
+
+// toGroqTools converts Tools into groqclient's ToolDefinition shape.
+func toGroqTools(tools []Tool) []groqclient.ToolDefinition {
+	if len(tools) == 0 {
+		return nil
+	}
+	defs := make([]groqclient.ToolDefinition, len(tools))
+	for i, t := range tools {
+		defs[i] = groqclient.ToolDefinition{
+			Type: t.Type,
+			Function: groqclient.FunctionDefinition{
+				Name:        t.Function.Name,
+				Description: t.Function.Description,
+				Parameters:  t.Function.Parameters,
+			},
+		}
+	}
+	return defs
+}
+
+// toGroqToolCalls converts ToolCalls into groqclient's ToolCall shape.
+func toGroqToolCalls(calls []ToolCall) []groqclient.ToolCall {
+	if len(calls) == 0 {
+		return nil
+	}
+	out := make([]groqclient.ToolCall, len(calls))
+	for i, c := range calls {
+		out[i] = groqclient.ToolCall{
+			ID:   c.ID,
+			Type: c.Type,
+			Function: groqclient.FunctionCall{
+				Name:      c.Function.Name,
+				Arguments: c.Function.Arguments,
+			},
+		}
+	}
+	return out
+}
+
+// fromGroqToolCalls converts groqclient's ToolCall shape back into
+// ToolCalls.
+func fromGroqToolCalls(calls []groqclient.ToolCall) []ToolCall {
+	if len(calls) == 0 {
+		return nil
+	}
+	out := make([]ToolCall, len(calls))
+	for i, c := range calls {
+		out[i] = ToolCall{
+			ID:   c.ID,
+			Type: c.Type,
+			Function: ToolCallFunction{
+				Name:      c.Function.Name,
+				Arguments: c.Function.Arguments,
+			},
+		}
+	}
+	return out
+}
+
+// Stream implements StreamProvider by delegating to
+// pkg/groqclient.StreamChatCompletion, translating its ChatChunk into
+// this package's provider-agnostic one.
+func (p *OpenAIProvider) Stream(ctx context.Context, req ChatRequest) (<-chan ChatChunk, error) {
+	model := req.Model
+	if model == "" {
+		model = p.defaultModel
+	}
+
+	source, err := p.client.StreamChatCompletion(ctx, groqclient.ChatCompletionRequest{
+		Model:       model,
+		Messages:    toGroqMessages(req),
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+		Tools:       toGroqTools(NormalizeTools(req)),
+		ToolChoice:  req.ToolChoice,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make(chan ChatChunk)
+	go func() {
+		defer close(chunks)
+		for c := range source {
+			chunk := ChatChunk{Delta: c.Delta, FinishReason: c.FinishReason, Err: c.Err}
+			if c.Usage != nil {
+				chunk.Usage = &Usage{Input: c.Usage.PromptTokens, Output: c.Usage.CompletionTokens, Total: c.Usage.TotalTokens}
+			}
+			chunks <- chunk
+		}
+	}()
+	return chunks, nil
+}
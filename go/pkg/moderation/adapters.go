@@ -0,0 +1,26 @@
+package moderation
+
+import "github.com/patdeg/ai101/go/pkg/promptguard"
+
+// PromptGuardLayer adapts any promptguard.Stage (the Prompt Guard client
+// itself, or the LlamaGuard stage) into a moderation Layer, under a
+// caller-chosen name so a Report can tell which stage flagged what.
+type PromptGuardLayer struct {
+	name  string
+	stage promptguard.Stage
+}
+
+// NewPromptGuardLayer wraps stage as a named Layer.
+func NewPromptGuardLayer(name string, stage promptguard.Stage) PromptGuardLayer {
+	return PromptGuardLayer{name: name, stage: stage}
+}
+
+func (l PromptGuardLayer) Name() string { return l.name }
+
+func (l PromptGuardLayer) Check(content string) (Verdict, error) {
+	verdict, err := l.stage.Check(content)
+	if err != nil {
+		return Verdict{}, err
+	}
+	return Verdict{Flagged: verdict.IsAttack(), Reason: string(verdict.Label), Score: verdict.Score}, nil
+}
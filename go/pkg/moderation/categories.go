@@ -0,0 +1,43 @@
+package moderation
+
+// categoryDescriptions maps LlamaGuard's S1-S14 safety taxonomy codes to
+// human-readable names, the same table 04_safety_check.go and
+// 05_safety_check.go each inline locally - centralized here so a
+// LlamaGuardModerator-based Verdict can be described without every
+// caller redefining the table.
+var categoryDescriptions = map[string]string{
+	"S1":  "Violent Crimes",
+	"S2":  "Non-Violent Crimes",
+	"S3":  "Sex-Related Crimes",
+	"S4":  "Child Sexual Exploitation",
+	"S5":  "Defamation",
+	"S6":  "Specialized Advice (financial, medical, legal)",
+	"S7":  "Privacy Violations",
+	"S8":  "Intellectual Property",
+	"S9":  "Indiscriminate Weapons",
+	"S10": "Hate Speech",
+	"S11": "Suicide & Self-Harm",
+	"S12": "Sexual Content",
+	"S13": "Elections",
+	"S14": "Code Interpreter Abuse",
+}
+
+// CategoryDescription returns the human-readable name for a LlamaGuard
+// category code (e.g. "S9" -> "Indiscriminate Weapons"), or "Unknown
+// Category" for a code this table doesn't recognize.
+func CategoryDescription(category string) string {
+	if description, ok := categoryDescriptions[category]; ok {
+		return description
+	}
+	return "Unknown Category"
+}
+
+// CategoryDescriptions maps every category in v.Categories to its
+// human-readable name, in the same order.
+func (v Verdict) CategoryDescriptions() []string {
+	descriptions := make([]string, len(v.Categories))
+	for i, category := range v.Categories {
+		descriptions[i] = CategoryDescription(category)
+	}
+	return descriptions
+}
@@ -0,0 +1,35 @@
+package moderation
+
+import "context"
+
+// Chain runs a sequence of Moderators in order, stopping at the first one
+// that flags content - e.g. a RegexModerator pre-filter ahead of a slower
+// LlamaGuardModerator call. This mirrors pkg/promptguard's Pipeline
+// (short-circuit on the first hit); it's distinct from this package's own
+// Pipeline, which always runs every Layer to build a full Report.
+type Chain struct {
+	moderators []Moderator
+}
+
+// NewChain builds a Chain that runs moderators in order.
+func NewChain(moderators ...Moderator) *Chain {
+	return &Chain{moderators: moderators}
+}
+
+// Check runs content through each Moderator in order and returns the first
+// flagged Verdict it finds, or the last (unflagged) Verdict if none fire.
+// A Chain is itself a Moderator, so chains can nest.
+func (c *Chain) Check(ctx context.Context, role, content string) (Verdict, error) {
+	var last Verdict
+	for _, moderator := range c.moderators {
+		verdict, err := moderator.Check(ctx, role, content)
+		if err != nil {
+			return Verdict{}, err
+		}
+		last = verdict
+		if verdict.Flagged {
+			return verdict, nil
+		}
+	}
+	return last, nil
+}
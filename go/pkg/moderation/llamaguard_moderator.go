@@ -0,0 +1,55 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+const llamaGuardModel = "meta-llama/llama-guard-4-12b"
+
+// LlamaGuardModerator is a Moderator backed by LlamaGuard's S1-S14 safety
+// taxonomy. Unlike promptguard.LlamaGuardStage, which only reports a
+// binary attack/benign Verdict, Check here returns every category
+// LlamaGuard flagged so a Policy can treat them differently (e.g. allow
+// S6 with a disclaimer, block S9 outright).
+type LlamaGuardModerator struct {
+	groq *groqclient.Client
+}
+
+// NewLlamaGuardModerator creates a LlamaGuardModerator backed by a
+// groqclient.Client pointed at baseURL.
+func NewLlamaGuardModerator(baseURL, apiKey string) *LlamaGuardModerator {
+	return &LlamaGuardModerator{groq: groqclient.New(baseURL, apiKey)}
+}
+
+// Check sends content, tagged with role, to LlamaGuard and parses its
+// "safe" / "unsafe\nS1,S2" response into a Verdict.
+func (m *LlamaGuardModerator) Check(ctx context.Context, role, content string) (Verdict, error) {
+	resp, err := m.groq.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model: llamaGuardModel,
+		Messages: []groqclient.Message{
+			groqclient.NewTextMessage(role, content),
+		},
+		MaxTokens: 100,
+	})
+	if err != nil {
+		return Verdict{}, err
+	}
+
+	raw := strings.TrimSpace(resp.Choices[0].Message.Content)
+	lines := strings.SplitN(raw, "\n", 2)
+	safe := strings.EqualFold(lines[0], "safe")
+
+	var categories []string
+	if !safe && len(lines) > 1 {
+		for _, category := range strings.Split(lines[1], ",") {
+			if category = strings.TrimSpace(category); category != "" {
+				categories = append(categories, category)
+			}
+		}
+	}
+
+	return Verdict{Flagged: !safe, Categories: categories, RawResponse: raw}, nil
+}
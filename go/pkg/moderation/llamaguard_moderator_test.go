@@ -0,0 +1,77 @@
+package moderation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+// fixtureServer starts an httptest.Server that replies to every request
+// with the contents of testdata/<fixture>.json, so LlamaGuardModerator.Check
+// can be exercised against every S1-S14 category (and the safe case)
+// without hitting the real Groq API.
+func fixtureServer(t *testing.T, fixture string) *httptest.Server {
+	t.Helper()
+	body, err := os.ReadFile("testdata/" + fixture + ".json")
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", fixture, err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestLlamaGuardModeratorCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		fixture      string
+		wantFlagged  bool
+		wantCategory string
+	}{
+		{"safe", "safe", false, ""},
+		{"S1 violent crimes", "s1", true, "S1"},
+		{"S2 non-violent crimes", "s2", true, "S2"},
+		{"S3 sex-related crimes", "s3", true, "S3"},
+		{"S4 child sexual exploitation", "s4", true, "S4"},
+		{"S5 defamation", "s5", true, "S5"},
+		{"S6 specialized advice", "s6", true, "S6"},
+		{"S7 privacy violations", "s7", true, "S7"},
+		{"S8 intellectual property", "s8", true, "S8"},
+		{"S9 indiscriminate weapons", "s9", true, "S9"},
+		{"S10 hate speech", "s10", true, "S10"},
+		{"S11 suicide & self-harm", "s11", true, "S11"},
+		{"S12 sexual content", "s12", true, "S12"},
+		{"S13 elections", "s13", true, "S13"},
+		{"S14 code interpreter abuse", "s14", true, "S14"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := fixtureServer(t, tt.fixture)
+			moderator := NewLlamaGuardModerator(server.URL, "test-key")
+
+			verdict, err := moderator.Check(context.Background(), "user", "some content")
+			if err != nil {
+				t.Fatalf("Check returned error: %v", err)
+			}
+
+			if verdict.Flagged != tt.wantFlagged {
+				t.Errorf("Flagged = %v, want %v", verdict.Flagged, tt.wantFlagged)
+			}
+			if tt.wantCategory == "" {
+				if len(verdict.Categories) != 0 {
+					t.Errorf("Categories = %v, want none", verdict.Categories)
+				}
+				return
+			}
+			if len(verdict.Categories) != 1 || verdict.Categories[0] != tt.wantCategory {
+				t.Errorf("Categories = %v, want [%s]", verdict.Categories, tt.wantCategory)
+			}
+		})
+	}
+}
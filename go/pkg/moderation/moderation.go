@@ -0,0 +1,86 @@
+/*
+Package moderation runs content through an ordered set of independent
+moderation checks ("layers" — a prompt-injection detector, a content-safety
+classifier, a keyword blocklist, whatever a deployment needs) and reports
+every layer's verdict, not just the first one that flags something.
+
+This complements pkg/promptguard's Pipeline, which exists purely to block a
+request as soon as any stage flags it. Pipeline here is for situations that
+want the full picture — logging, a moderation dashboard, or a policy that
+only blocks once N layers agree — so it always runs every layer.
+
+Moderator, Chain, and Policy (see moderator.go, chain.go, policy.go) build
+on the same Verdict for a narrower case: a LlamaGuard-style check that
+reports which taxonomy category (S1-S14) fired, and a Policy that maps
+each category to an action instead of a single flagged/not-flagged call.
+*/
+package moderation
+
+// Verdict is one check's judgment on a piece of content. Flagged/Reason/
+// Score are set by a Layer; Categories/RawResponse are set by a Moderator
+// (a LlamaGuard-style check reports the taxonomy categories it matched
+// rather than a single reason string).
+type Verdict struct {
+	Flagged     bool
+	Reason      string
+	Score       float64
+	Categories  []string
+	RawResponse string
+}
+
+// Layer is one independent moderation check.
+type Layer interface {
+	Name() string
+	Check(content string) (Verdict, error)
+}
+
+// LayerFunc adapts a plain function into a Layer, for simple checks that
+// don't need their own type (a keyword blocklist, a regex rule).
+type LayerFunc struct {
+	LayerName string
+	CheckFunc func(content string) (Verdict, error)
+}
+
+func (f LayerFunc) Name() string { return f.LayerName }
+
+func (f LayerFunc) Check(content string) (Verdict, error) { return f.CheckFunc(content) }
+
+// Result is one layer's outcome within a Report.
+type Result struct {
+	Layer   string
+	Verdict Verdict
+	Err     error
+}
+
+// Report is the outcome of running every layer of a Pipeline against one
+// piece of content.
+type Report struct {
+	Flagged bool
+	Results []Result
+}
+
+// Pipeline runs a fixed, ordered set of moderation layers against content.
+type Pipeline struct {
+	layers []Layer
+}
+
+// NewPipeline builds a Pipeline from layers, run in the given order.
+func NewPipeline(layers ...Layer) *Pipeline {
+	return &Pipeline{layers: layers}
+}
+
+// Run checks content against every layer and returns a full Report. A
+// layer that errors contributes its Err to the Report but does not by
+// itself flag the content — an unreachable safety model shouldn't silently
+// block every request.
+func (p *Pipeline) Run(content string) Report {
+	report := Report{Results: make([]Result, 0, len(p.layers))}
+	for _, layer := range p.layers {
+		verdict, err := layer.Check(content)
+		report.Results = append(report.Results, Result{Layer: layer.Name(), Verdict: verdict, Err: err})
+		if err == nil && verdict.Flagged {
+			report.Flagged = true
+		}
+	}
+	return report
+}
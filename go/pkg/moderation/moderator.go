@@ -0,0 +1,13 @@
+package moderation
+
+import "context"
+
+// Moderator is a content-safety check that's aware of which chat turn it's
+// looking at (LlamaGuard's prompt differs for a user turn vs. an assistant
+// turn) and cancellable via ctx, unlike the simpler content-only Layer
+// in moderation.go. Implementations report which categories they flagged in the
+// returned Verdict rather than a single Flagged bool, so a Policy can
+// decide per category what to do about it.
+type Moderator interface {
+	Check(ctx context.Context, role, content string) (Verdict, error)
+}
@@ -0,0 +1,126 @@
+package moderation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Action is what a Policy says to do once a category has been flagged.
+type Action string
+
+const (
+	ActionAllow        Action = "allow"
+	ActionWarn         Action = "warn"
+	ActionRewriteRetry Action = "rewrite-and-retry"
+	ActionRedact       Action = "redact"
+	ActionBlock        Action = "block"
+)
+
+// severity orders Actions from least to most restrictive, so Evaluate can
+// pick the single worst action across every category a Verdict flagged.
+//
+// ActionRewriteRetry sits above Warn but below Redact: unlike the other
+// actions, a Moderator-agnostic caller like Wrapper can't carry it out by
+// itself (rewriting requires re-prompting the LLM that generated or will
+// generate the content), so Wrapper surfaces it as an error rather than
+// acting on it - see Wrapper.checkTurn's doc comment.
+var severity = map[Action]int{
+	ActionAllow:        0,
+	ActionWarn:         1,
+	ActionRewriteRetry: 2,
+	ActionRedact:       3,
+	ActionBlock:        4,
+}
+
+// Policy maps LlamaGuard's S1-S14 safety categories to an Action, so
+// operators can, e.g., allow S6 (specialized advice) with a disclaimer
+// while blocking S9 (indiscriminate weapons) - instead of a Moderator
+// hard-coding a single block/allow call.
+type Policy struct {
+	rules         map[string]Action
+	defaultAction Action
+}
+
+// NewPolicy builds a Policy from rules, falling back to defaultAction for
+// any category rules doesn't mention.
+func NewPolicy(rules map[string]Action, defaultAction Action) *Policy {
+	return &Policy{rules: rules, defaultAction: defaultAction}
+}
+
+// LoadPolicy reads a category->action mapping from a JSON or YAML file
+// (dispatched on its extension) and builds a Policy with defaultAction as
+// the fallback for any category the file doesn't mention.
+func LoadPolicy(path string, defaultAction Action) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	var rules map[string]Action
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		if err := json.Unmarshal(data, &rules); err != nil {
+			return nil, fmt.Errorf("failed to parse policy JSON: %w", err)
+		}
+	case ".yaml", ".yml":
+		rules, err = parsePolicyYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse policy YAML: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unrecognized policy file extension %q (want .json, .yaml, or .yml)", ext)
+	}
+
+	return NewPolicy(rules, defaultAction), nil
+}
+
+// parsePolicyYAML reads the flat "category: action" mapping a policy file
+// needs. It isn't a general YAML parser - just enough for one level of
+// "key: value" lines, "#" comments, and blank lines, which is all a flat
+// category->action table requires.
+func parsePolicyYAML(data []byte) (map[string]Action, error) {
+	rules := make(map[string]Action)
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("malformed line %q (want \"category: action\")", line)
+		}
+		rules[strings.TrimSpace(key)] = Action(strings.TrimSpace(value))
+	}
+	return rules, nil
+}
+
+// ActionFor returns the Action configured for category, or the Policy's
+// default if category isn't listed.
+func (p *Policy) ActionFor(category string) Action {
+	if action, ok := p.rules[category]; ok {
+		return action
+	}
+	return p.defaultAction
+}
+
+// Evaluate returns the single most restrictive Action across every
+// category verdict flagged, or ActionAllow if verdict wasn't flagged.
+func (p *Policy) Evaluate(verdict Verdict) Action {
+	if !verdict.Flagged {
+		return ActionAllow
+	}
+	if len(verdict.Categories) == 0 {
+		return p.defaultAction
+	}
+
+	worst := ActionAllow
+	for _, category := range verdict.Categories {
+		if action := p.ActionFor(category); severity[action] > severity[worst] {
+			worst = action
+		}
+	}
+	return worst
+}
@@ -0,0 +1,40 @@
+package moderation
+
+import (
+	"context"
+	"regexp"
+)
+
+// RegexRule maps a compiled pattern to the category it represents, so a
+// RegexModerator's Verdict carries the same kind of category label a
+// LlamaGuardModerator would, even though the check itself is a cheap local
+// regex rather than a model call.
+type RegexRule struct {
+	Category string
+	Pattern  *regexp.Regexp
+}
+
+// RegexModerator is a fast, local pre-filter for content that's obviously
+// unsafe without waiting on an LLM call - meant to run first in a Chain so
+// a slower, paid LlamaGuardModerator only sees what gets past it.
+type RegexModerator struct {
+	rules []RegexRule
+}
+
+// NewRegexModerator builds a RegexModerator from rules, checked in order.
+func NewRegexModerator(rules ...RegexRule) *RegexModerator {
+	return &RegexModerator{rules: rules}
+}
+
+// Check matches content against every rule and reports every category
+// whose pattern matched. role is accepted to satisfy Moderator but
+// otherwise unused - a regex rule doesn't care which turn it's looking at.
+func (m *RegexModerator) Check(ctx context.Context, role, content string) (Verdict, error) {
+	var categories []string
+	for _, rule := range m.rules {
+		if rule.Pattern.MatchString(content) {
+			categories = append(categories, rule.Category)
+		}
+	}
+	return Verdict{Flagged: len(categories) > 0, Categories: categories, RawResponse: content}, nil
+}
@@ -0,0 +1,155 @@
+package moderation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+	"github.com/patdeg/ai101/go/pkg/notify"
+)
+
+// ErrBlocked is returned by Wrapper.CreateChatCompletion when a chat
+// turn's Policy action is ActionBlock.
+var ErrBlocked = errors.New("moderation: content blocked by policy")
+
+// ErrRewriteRequested is returned by Wrapper.CreateChatCompletion when a
+// chat turn's Policy action is ActionRewriteRetry. Wrapper has no way to
+// rewrite the turn itself - doing so means re-prompting the LLM with the
+// flagged content and a rewrite instruction, which only the caller (who
+// owns the conversation and the retry budget) can do - so it surfaces the
+// request as this error instead of silently blocking or passing it
+// through. A caller that wants rewrite-and-retry behavior should catch
+// this error, build a new request asking the model to rephrase, and call
+// CreateChatCompletion again.
+var ErrRewriteRequested = errors.New("moderation: content flagged for rewrite-and-retry")
+
+const redactedPlaceholder = "[redacted by moderation policy]"
+
+// Wrapper moderates every chat turn sent through a groqclient.Client: the
+// outgoing user message before it's sent, and every returned assistant
+// message before CreateChatCompletion hands it back. Any example in this
+// module can opt into moderation by swapping groqclient.New(...) for
+// moderation.Wrap(groqclient.New(...), moderator, policy), since Wrapper
+// embeds *groqclient.Client and shadows only the one method that needs
+// checking; that method also takes a ctx as its first argument, so
+// CreateChatCompletion call sites pass one.
+//
+// Setting Notifier sends a notify.Event for every Block verdict, so an
+// operator running one of these examples as a service hears about abuse
+// attempts in real time instead of only in a local log.
+type Wrapper struct {
+	*groqclient.Client
+	moderator Moderator
+	policy    *Policy
+	Notifier  notify.Notifier
+}
+
+// Wrap builds a Wrapper around client that checks every turn against
+// moderator and policy.
+func Wrap(client *groqclient.Client, moderator Moderator, policy *Policy) *Wrapper {
+	return &Wrapper{Client: client, moderator: moderator, policy: policy}
+}
+
+// CreateChatCompletion moderates the last user message in req before
+// sending it, then moderates every returned choice's content before
+// returning the response, redacting or blocking per w.policy. Attach a
+// request ID to ctx with notify.WithRequestID so a Block notification can
+// be correlated back to the request that triggered it.
+func (w *Wrapper) CreateChatCompletion(ctx context.Context, req groqclient.ChatCompletionRequest) (*groqclient.ChatCompletionResponse, error) {
+	if err := w.checkTurn(ctx, "user", lastUserMessage(req.Messages)); err != nil {
+		return nil, err
+	}
+
+	resp, err := w.Client.CreateChatCompletion(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Choices {
+		content := resp.Choices[i].Message.Content
+		if content == "" {
+			continue
+		}
+		verdict, err := w.moderator.Check(ctx, "assistant", content)
+		if err != nil {
+			return nil, fmt.Errorf("moderation check on assistant response failed: %w", err)
+		}
+		switch w.policy.Evaluate(verdict) {
+		case ActionBlock:
+			w.notifyBlock(ctx, "assistant", content, verdict)
+			return nil, fmt.Errorf("%w: assistant response flagged %v", ErrBlocked, verdict.Categories)
+		case ActionRewriteRetry:
+			return nil, fmt.Errorf("%w: assistant response flagged %v", ErrRewriteRequested, verdict.Categories)
+		case ActionRedact:
+			resp.Choices[i].Message.Content = redactedPlaceholder
+		}
+	}
+	return resp, nil
+}
+
+// lastUserMessage returns the most recent user turn's text content, or ""
+// if there isn't one or its content isn't plain text (multimodal content
+// isn't moderated here).
+func lastUserMessage(messages []groqclient.Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role != "user" {
+			continue
+		}
+		text, _ := messages[i].Content.(string)
+		return text
+	}
+	return ""
+}
+
+// checkTurn moderates one role-tagged turn, returning ErrBlocked or
+// ErrRewriteRequested if the policy's action for it is ActionBlock or
+// ActionRewriteRetry respectively. An empty content (nothing to check) is
+// always allowed.
+func (w *Wrapper) checkTurn(ctx context.Context, role, content string) error {
+	if content == "" {
+		return nil
+	}
+	verdict, err := w.moderator.Check(ctx, role, content)
+	if err != nil {
+		return fmt.Errorf("moderation check on %s message failed: %w", role, err)
+	}
+	switch w.policy.Evaluate(verdict) {
+	case ActionBlock:
+		w.notifyBlock(ctx, role, content, verdict)
+		return fmt.Errorf("%w: %s message flagged %v", ErrBlocked, role, verdict.Categories)
+	case ActionRewriteRetry:
+		return fmt.Errorf("%w: %s message flagged %v", ErrRewriteRequested, role, verdict.Categories)
+	}
+	return nil
+}
+
+// notifyBlock sends a notify.Event for a blocked turn, carrying the
+// flagged categories, a truncated hash of the content (never the content
+// itself), the time, and any request ID attached to ctx. A no-op if
+// w.Notifier is unset.
+func (w *Wrapper) notifyBlock(ctx context.Context, role, content string, verdict Verdict) {
+	if w.Notifier == nil {
+		return
+	}
+	hash := sha256.Sum256([]byte(content))
+	event := notify.Event{
+		Type:      "moderation_block",
+		Severity:  notify.SeverityBlock,
+		Message:   fmt.Sprintf("%s message blocked by moderation policy", role),
+		Timestamp: time.Now(),
+		Fields: map[string]string{
+			"category":     strings.Join(verdict.Categories, ","),
+			"content_hash": hex.EncodeToString(hash[:])[:16],
+			"request_id":   notify.RequestIDFromContext(ctx),
+		},
+	}
+	if err := w.Notifier.Send(ctx, event); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to send moderation-block notification: %v\n", err)
+	}
+}
@@ -0,0 +1,146 @@
+package notify
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Env vars that build a Dispatcher with zero configuration file, for
+// examples that want notifications without a notify.yaml.
+const (
+	EnvAppriseURL      = "APPRISE_URL"
+	EnvSlackWebhookURL = "SLACK_WEBHOOK_URL"
+)
+
+// FromEnv builds a Dispatcher from APPRISE_URL / SLACK_WEBHOOK_URL,
+// forwarding every severity since there's no config file to filter with.
+// Returns a Dispatcher with no sinks (Send becomes a no-op) if neither is
+// set.
+func FromEnv() (*Dispatcher, error) {
+	var sinks []Notifier
+
+	if url := os.Getenv(EnvAppriseURL); url != "" {
+		sink, err := NewAppriseSink(url, SeverityInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build Apprise sink from %s: %w", EnvAppriseURL, err)
+		}
+		sinks = append(sinks, sink)
+	}
+	if url := os.Getenv(EnvSlackWebhookURL); url != "" {
+		sink, err := NewSlackSink(url, SeverityInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build Slack sink from %s: %w", EnvSlackWebhookURL, err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	return NewDispatcher(sinks...), nil
+}
+
+// sinkConfig is one entry in notify.yaml: a sink type, its destination
+// URL, and the minimum Severity it should be notified about.
+type sinkConfig struct {
+	Type        string
+	URL         string
+	MinSeverity Severity
+}
+
+// LoadConfigFile reads a notify.yaml listing sinks and their severity
+// filters and builds a Dispatcher over them. It isn't a general YAML
+// parser - just enough for a flat "sinks:" list of "- type/url/
+// min_severity" maps, which is all notify.yaml needs:
+//
+//	sinks:
+//	  - type: apprise
+//	    url: http://localhost:8000/notify
+//	    min_severity: warn
+//	  - type: slack
+//	    url: https://hooks.slack.com/services/...
+//	    min_severity: block
+func LoadConfigFile(path string) (*Dispatcher, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notify config: %w", err)
+	}
+
+	configs, err := parseSinkList(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse notify config: %w", err)
+	}
+
+	sinks := make([]Notifier, 0, len(configs))
+	for _, cfg := range configs {
+		sink, err := buildSink(cfg)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return NewDispatcher(sinks...), nil
+}
+
+// parseSinkList parses the "sinks:" list in notify.yaml: each entry starts
+// with a "- key: value" line and continues with further indented
+// "key: value" lines until the next "- " entry or end of input.
+func parseSinkList(data string) ([]sinkConfig, error) {
+	var configs []sinkConfig
+	var current *sinkConfig
+
+	for _, raw := range strings.Split(data, "\n") {
+		trimmed := strings.TrimSpace(raw)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "sinks:" {
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "- ") {
+			if current != nil {
+				configs = append(configs, *current)
+			}
+			current = &sinkConfig{}
+			trimmed = strings.TrimPrefix(trimmed, "- ")
+		}
+		if current == nil {
+			return nil, fmt.Errorf("malformed line %q (expected a \"- \" list entry)", raw)
+		}
+
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			return nil, fmt.Errorf("malformed line %q (want \"key: value\")", raw)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+
+		switch key {
+		case "type":
+			current.Type = value
+		case "url":
+			current.URL = value
+		case "min_severity":
+			severity, err := ParseSeverity(value)
+			if err != nil {
+				return nil, err
+			}
+			current.MinSeverity = severity
+		default:
+			return nil, fmt.Errorf("unrecognized notify.yaml field %q", key)
+		}
+	}
+	if current != nil {
+		configs = append(configs, *current)
+	}
+	return configs, nil
+}
+
+func buildSink(cfg sinkConfig) (Notifier, error) {
+	switch cfg.Type {
+	case "apprise":
+		return NewAppriseSink(cfg.URL, cfg.MinSeverity)
+	case "slack":
+		return NewSlackSink(cfg.URL, cfg.MinSeverity)
+	case "webhook":
+		return NewWebhookSink(cfg.URL, cfg.MinSeverity)
+	default:
+		return nil, fmt.Errorf("unrecognized notify.yaml sink type %q", cfg.Type)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Package notify sends real-time alerts to external services when one of
+this module's examples detects abuse or a failure worth paging someone
+over - a moderation Block verdict, a Tavily extraction failure, and so on.
+
+Modeled on miniflux's use of the Apprise notification gateway: one
+Notifier interface, with adapters for an Apprise HTTP endpoint, a Slack
+incoming webhook, and a generic webhook, so an example that wants "on
+failure, alert someone" doesn't need to pick a vendor. Sinks and their
+severity filters are configured via APPRISE_URL / SLACK_WEBHOOK_URL or a
+notify.yaml file (see config.go), so examples running as small services
+get alerts without a code change.
+*/
+package notify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Severity orders how urgent an Event is, so a sink only forwards events
+// at or above the threshold it was configured with.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarn
+	SeverityBlock
+)
+
+// ParseSeverity parses the lowercase names notify.yaml and this package's
+// docs use ("info", "warn", "block") into a Severity.
+func ParseSeverity(s string) (Severity, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "info":
+		return SeverityInfo, nil
+	case "warn", "warning":
+		return SeverityWarn, nil
+	case "block":
+		return SeverityBlock, nil
+	default:
+		return 0, fmt.Errorf("unrecognized severity %q (want info, warn, or block)", s)
+	}
+}
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarn:
+		return "warn"
+	case SeverityBlock:
+		return "block"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is one thing worth notifying an operator about.
+type Event struct {
+	Type      string // e.g. "moderation_block", "extraction_failed"
+	Severity  Severity
+	Message   string
+	Fields    map[string]string
+	Timestamp time.Time
+}
+
+// Notifier delivers one Event to an external service.
+type Notifier interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// Dispatcher fans an Event out to every configured Notifier, continuing
+// past a failing sink rather than stopping at the first error - a
+// misconfigured Slack webhook shouldn't also silence Apprise.
+type Dispatcher struct {
+	sinks []Notifier
+}
+
+// NewDispatcher builds a Dispatcher over sinks.
+func NewDispatcher(sinks ...Notifier) *Dispatcher {
+	return &Dispatcher{sinks: sinks}
+}
+
+// Send delivers event to every sink, returning the first error
+// encountered (after still attempting every sink).
+func (d *Dispatcher) Send(ctx context.Context, event Event) error {
+	var firstErr error
+	for _, sink := range d.sinks {
+		if err := sink.Send(ctx, event); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+type contextKey int
+
+const requestIDKey contextKey = 0
+
+// WithRequestID attaches a request ID to ctx, picked up by any Event built
+// downstream (moderation.Wrapper, tavily.Client) so an operator can
+// correlate an alert back to the request that triggered it.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext returns the request ID attached via WithRequestID,
+// or "" if none was set.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
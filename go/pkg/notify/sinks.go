@@ -0,0 +1,133 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/httpx"
+)
+
+// formatBody renders an Event as plain text: its message, followed by one
+// "key: value" line per Fields entry.
+func formatBody(event Event) string {
+	var b strings.Builder
+	b.WriteString(event.Message)
+	for key, value := range event.Fields {
+		fmt.Fprintf(&b, "\n%s: %s", key, value)
+	}
+	return b.String()
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notify payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build notify request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("notify request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("notify endpoint returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// AppriseSink posts events to an Apprise API server
+// (https://github.com/caronc/apprise-api), the same gateway miniflux uses
+// to fan a single notification out to dozens of services without this
+// module needing an adapter per vendor.
+type AppriseSink struct {
+	url         string
+	minSeverity Severity
+	httpClient  *http.Client
+}
+
+// NewAppriseSink builds an AppriseSink posting to url, only forwarding
+// events at or above minSeverity.
+func NewAppriseSink(url string, minSeverity Severity) (*AppriseSink, error) {
+	httpClient, err := httpx.DefaultClient()
+	if err != nil {
+		return nil, err
+	}
+	return &AppriseSink{url: url, minSeverity: minSeverity, httpClient: httpClient}, nil
+}
+
+// Send posts event to the Apprise endpoint as {"title", "body"}, Apprise's
+// own notify payload shape.
+func (s *AppriseSink) Send(ctx context.Context, event Event) error {
+	if event.Severity < s.minSeverity {
+		return nil
+	}
+	return postJSON(ctx, s.httpClient, s.url, map[string]string{
+		"title": event.Type,
+		"body":  formatBody(event),
+	})
+}
+
+// SlackSink posts events to a Slack incoming webhook.
+type SlackSink struct {
+	url         string
+	minSeverity Severity
+	httpClient  *http.Client
+}
+
+// NewSlackSink builds a SlackSink posting to a Slack incoming webhook URL,
+// only forwarding events at or above minSeverity.
+func NewSlackSink(url string, minSeverity Severity) (*SlackSink, error) {
+	httpClient, err := httpx.DefaultClient()
+	if err != nil {
+		return nil, err
+	}
+	return &SlackSink{url: url, minSeverity: minSeverity, httpClient: httpClient}, nil
+}
+
+// Send posts event to the Slack webhook as {"text": "..."}, the minimal
+// payload Slack's incoming webhooks accept.
+func (s *SlackSink) Send(ctx context.Context, event Event) error {
+	if event.Severity < s.minSeverity {
+		return nil
+	}
+	return postJSON(ctx, s.httpClient, s.url, map[string]string{
+		"text": fmt.Sprintf("[%s] %s", event.Severity, formatBody(event)),
+	})
+}
+
+// WebhookSink posts the full Event as JSON to a generic HTTP endpoint, for
+// operators with their own alerting pipeline rather than Apprise or Slack.
+type WebhookSink struct {
+	url         string
+	minSeverity Severity
+	httpClient  *http.Client
+}
+
+// NewWebhookSink builds a WebhookSink posting to url, only forwarding
+// events at or above minSeverity.
+func NewWebhookSink(url string, minSeverity Severity) (*WebhookSink, error) {
+	httpClient, err := httpx.DefaultClient()
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookSink{url: url, minSeverity: minSeverity, httpClient: httpClient}, nil
+}
+
+// Send posts event's full JSON representation to the webhook URL.
+func (s *WebhookSink) Send(ctx context.Context, event Event) error {
+	if event.Severity < s.minSeverity {
+		return nil
+	}
+	return postJSON(ctx, s.httpClient, s.url, event)
+}
@@ -0,0 +1,96 @@
+/*
+Package phash computes a perceptual hash (average hash / aHash) for images,
+so near-identical images — the same photo re-saved, re-compressed, or
+resized — can be recognized as "the same picture" even when their bytes,
+and therefore their sha256, differ completely.
+
+Uses Go standard library only: image.Decode plus the image/jpeg,
+image/png, and image/gif format registrations.
+*/
+package phash
+
+import (
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math/bits"
+)
+
+// hashSize is the side length of the grid an image is downsampled to
+// before hashing; 8x8 is the standard aHash size, giving a 64-bit hash.
+const hashSize = 8
+
+// Hash is a 64-bit average hash: each bit says whether the corresponding
+// cell of an 8x8 downsampled, grayscale version of the image was brighter
+// or darker than the image's average brightness.
+type Hash uint64
+
+// Compute decodes an image from r and returns its perceptual hash.
+func Compute(r io.Reader) (Hash, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return 0, err
+	}
+	return fromImage(img), nil
+}
+
+// fromImage downsamples img to hashSize x hashSize by averaging each cell's
+// pixels (simple box sampling — no interpolation library needed), then sets
+// a bit for every cell at or above the overall mean brightness.
+func fromImage(img image.Image) Hash {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	var cells [hashSize * hashSize]float64
+	for y := 0; y < hashSize; y++ {
+		for x := 0; x < hashSize; x++ {
+			sx0 := bounds.Min.X + x*w/hashSize
+			sx1 := bounds.Min.X + (x+1)*w/hashSize
+			sy0 := bounds.Min.Y + y*h/hashSize
+			sy1 := bounds.Min.Y + (y+1)*h/hashSize
+			if sx1 <= sx0 {
+				sx1 = sx0 + 1
+			}
+			if sy1 <= sy0 {
+				sy1 = sy0 + 1
+			}
+
+			var sum float64
+			var count int
+			for sy := sy0; sy < sy1 && sy < bounds.Max.Y; sy++ {
+				for sx := sx0; sx < sx1 && sx < bounds.Max.X; sx++ {
+					r, g, b, _ := img.At(sx, sy).RGBA()
+					sum += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)
+					count++
+				}
+			}
+			if count > 0 {
+				cells[y*hashSize+x] = sum / float64(count)
+			}
+		}
+	}
+
+	var mean float64
+	for _, v := range cells {
+		mean += v
+	}
+	mean /= float64(len(cells))
+
+	var hash Hash
+	for i, v := range cells {
+		if v >= mean {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// Distance returns the Hamming distance between two hashes: how many of
+// their 64 bits differ. 0 means identical downsampled images; in practice,
+// re-compressed or lightly-edited copies of the same photo land within
+// 5-10.
+func Distance(a, b Hash) int {
+	return bits.OnesCount64(uint64(a ^ b))
+}
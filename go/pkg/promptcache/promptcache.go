@@ -0,0 +1,170 @@
+/*
+Package promptcache helps verify and optimize Groq prompt-cache hits.
+
+Analyze reads usage.prompt_tokens_details.cached_tokens from a chat
+completion response and reports the realized savings. Reorder stably sorts
+messages so the most constant ones (system prompts, previously-seen
+turns) come first and the newest user turn comes last, which is what
+actually earns a cache hit on the next call. A turn counts as previously
+seen if its exact text was observed before, whatever its length; turns
+are not filtered by size.
+*/
+package promptcache
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+// PricePerMillion describes a model's cached vs. uncached input pricing.
+type PricePerMillion struct {
+	Input       float64
+	CachedInput float64
+}
+
+// Analysis is the result of Analyze: how much of the prompt was served
+// from cache and what that saved versus paying full price for it.
+type Analysis struct {
+	PromptTokens int
+	CachedTokens int
+	HitRatio     float64 // CachedTokens / PromptTokens, 0 if PromptTokens is 0
+	FullCost     float64 // what PromptTokens would have cost with no caching
+	ActualCost   float64 // what PromptTokens actually cost, cached tokens discounted
+	Savings      float64 // FullCost - ActualCost
+}
+
+// Analyze computes cache-hit statistics for one response's prompt tokens.
+func Analyze(resp *groqclient.ChatCompletionResponse, price PricePerMillion) Analysis {
+	promptTokens := resp.Usage.PromptTokens
+	cachedTokens := 0
+	if resp.Usage.PromptTokensDetails != nil {
+		cachedTokens = resp.Usage.PromptTokensDetails.CachedTokens
+	}
+	uncachedTokens := promptTokens - cachedTokens
+
+	fullCost := float64(promptTokens) / 1_000_000 * price.Input
+	actualCost := float64(uncachedTokens)/1_000_000*price.Input + float64(cachedTokens)/1_000_000*price.CachedInput
+
+	var hitRatio float64
+	if promptTokens > 0 {
+		hitRatio = float64(cachedTokens) / float64(promptTokens)
+	}
+
+	return Analysis{
+		PromptTokens: promptTokens,
+		CachedTokens: cachedTokens,
+		HitRatio:     hitRatio,
+		FullCost:     fullCost,
+		ActualCost:   actualCost,
+		Savings:      fullCost - actualCost,
+	}
+}
+
+// History tracks which message hashes have been seen across previous calls,
+// persisted to disk so Reorder can recognize repeated content across
+// process runs, not just within one.
+type History struct {
+	path string
+	seen map[string]int // sha1(content) -> times seen
+}
+
+func defaultHistoryPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".ai101/prompt-history.json"
+	}
+	return filepath.Join(home, ".ai101", "prompt-history.json")
+}
+
+// LoadHistory reads the persisted history from path, or from
+// ~/.ai101/prompt-history.json if path is empty. A missing file is not an
+// error; it just starts empty.
+func LoadHistory(path string) (*History, error) {
+	if path == "" {
+		path = defaultHistoryPath()
+	}
+	h := &History{path: path, seen: make(map[string]int)}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return h, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &h.seen); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Save persists the history to disk.
+func (h *History) Save() error {
+	if err := os.MkdirAll(filepath.Dir(h.path), 0755); err != nil {
+		return err
+	}
+	raw, err := json.MarshalIndent(h.seen, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(h.path, raw, 0644)
+}
+
+func hashContent(content string) string {
+	sum := sha1.Sum([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// Observe records that content was used in a call, so future Reorder calls
+// recognize it as "previously seen".
+func (h *History) Observe(content string) {
+	h.seen[hashContent(content)]++
+}
+
+// volatility scores a message from most-constant (0) to most-variable (2):
+// system prompts are always 0, previously-seen user/assistant turns are 1,
+// and anything new is 2 (the newest user turn should end up last).
+func (h *History) volatility(msg groqclient.Message) int {
+	if msg.Role == "system" {
+		return 0
+	}
+	text, ok := msg.Content.(string)
+	if !ok {
+		return 2
+	}
+	if h.seen[hashContent(text)] > 0 {
+		return 1
+	}
+	return 2
+}
+
+// Reorder stably sorts messages by volatility score so that system prompts
+// come first, previously-seen turns (of any length) come next, and the
+// newest user turn comes last — the ordering Groq's prompt cache rewards.
+func (h *History) Reorder(messages []groqclient.Message) []groqclient.Message {
+	scored := make([]struct {
+		msg   groqclient.Message
+		score int
+	}, len(messages))
+	for i, m := range messages {
+		scored[i].msg = m
+		scored[i].score = h.volatility(m)
+	}
+
+	// Group by score with one pass per score (0, 1, 2), preserving the
+	// original order within each group, which makes the result stable.
+	result := make([]groqclient.Message, 0, len(messages))
+	for score := 0; score <= 2; score++ {
+		for _, s := range scored {
+			if s.score == score {
+				result = append(result, s.msg)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,76 @@
+package promptguard
+
+import (
+	"sync"
+	"time"
+)
+
+// BruteForceDetector tracks per-message Verdicts from a single identity
+// (an IP, API key, or session ID) over a sliding window, and flags a
+// brute-force / extraction attempt when that identity accumulates too many
+// attacks too quickly — a single Scan call can only say "this message looks
+// like an attack", not "this caller is systematically probing us".
+type BruteForceDetector struct {
+	window    time.Duration
+	threshold int
+
+	mu      sync.Mutex
+	history map[string][]time.Time // identity -> timestamps of recent attack verdicts
+}
+
+// NewBruteForceDetector flags an identity once it has recorded at least
+// threshold attack verdicts within window.
+func NewBruteForceDetector(window time.Duration, threshold int) *BruteForceDetector {
+	return &BruteForceDetector{
+		window:    window,
+		threshold: threshold,
+		history:   make(map[string][]time.Time),
+	}
+}
+
+// Observe records verdict for identity and reports whether that identity
+// has now crossed the brute-force threshold.
+func (d *BruteForceDetector) Observe(identity string, verdict Verdict, now time.Time) bool {
+	if !verdict.IsAttack() {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	cutoff := now.Add(-d.window)
+	recent := d.history[identity][:0]
+	for _, t := range d.history[identity] {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+	recent = append(recent, now)
+	d.history[identity] = recent
+
+	return len(recent) >= d.threshold
+}
+
+// Count returns how many attack verdicts identity has within the current
+// window, without recording a new observation.
+func (d *BruteForceDetector) Count(identity string, now time.Time) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	cutoff := now.Add(-d.window)
+	count := 0
+	for _, t := range d.history[identity] {
+		if t.After(cutoff) {
+			count++
+		}
+	}
+	return count
+}
+
+// Reset clears recorded history for identity, e.g. after a manual review
+// clears them.
+func (d *BruteForceDetector) Reset(identity string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.history, identity)
+}
@@ -0,0 +1,48 @@
+package promptguard
+
+import (
+	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+const llamaGuardModel = "meta-llama/llama-guard-4-12b"
+
+// LlamaGuardStage is a Pipeline Stage backed by LlamaGuard's text
+// moderation, for use alongside a Prompt Guard Client — the two layers
+// described throughout this repo's security examples.
+type LlamaGuardStage struct {
+	groq *groqclient.Client
+}
+
+// NewLlamaGuardStage creates a LlamaGuardStage backed by a groqclient.Client
+// pointed at baseURL.
+func NewLlamaGuardStage(baseURL, apiKey string) *LlamaGuardStage {
+	return &LlamaGuardStage{groq: groqclient.New(baseURL, apiKey)}
+}
+
+// Check sends message to LlamaGuard and reports it as an "attack" (reusing
+// Verdict/Label so it composes with Prompt Guard in a Pipeline) if the
+// content is flagged unsafe.
+func (s *LlamaGuardStage) Check(message string) (Verdict, error) {
+	resp, err := s.groq.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model: llamaGuardModel,
+		Messages: []groqclient.Message{
+			groqclient.NewTextMessage("user", message),
+		},
+		MaxTokens: 100,
+	})
+	if err != nil {
+		return Verdict{}, err
+	}
+
+	content := strings.ToLower(strings.TrimSpace(resp.Choices[0].Message.Content))
+	label := LabelBenign
+	score := 0.0
+	if !strings.HasPrefix(content, "safe") {
+		label = LabelInjection // LlamaGuard flags content-policy violations, distinct from Prompt Guard's jailbreak detection
+		score = 1.0
+	}
+
+	return Verdict{Label: label, Score: score, Usage: resp.Usage}, nil
+}
@@ -0,0 +1,147 @@
+package promptguard
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/patdeg/ai101/go/pkg/secevents"
+)
+
+// incomingBody is the minimal shape this middleware needs from a JSON
+// request body: the user-supplied text to scan. Downstream handlers
+// typically expect a richer body (e.g. a chat completion request); the
+// middleware only peeks at "message" before restoring the body for them.
+type incomingBody struct {
+	Message string `json:"message"`
+}
+
+// scanRequest reads r's body, restores it for downstream handlers, and
+// runs any "message" field through pipeline. ok is false if there was
+// nothing to scan (malformed or message-less body), in which case verdict
+// is the zero value and the caller should let the request through.
+func scanRequest(r *http.Request, pipeline *Pipeline) (verdict Verdict, ok bool, err error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return Verdict{}, false, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	var incoming incomingBody
+	if json.Unmarshal(body, &incoming) != nil || incoming.Message == "" {
+		return Verdict{}, false, nil
+	}
+
+	verdict, err = pipeline.Run(incoming.Message)
+	if err != nil {
+		return Verdict{}, false, err
+	}
+	return verdict, true, nil
+}
+
+func writeJSONError(w http.ResponseWriter, status int, fields map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(fields)
+}
+
+// Middleware gates a downstream http.Handler behind a Pipeline (typically
+// Prompt Guard, then LlamaGuard). It reads the request body's "message"
+// field, runs it through the pipeline, and responds 403 Forbidden with the
+// triggering Verdict as JSON if an attack is detected — otherwise it
+// restores the body (so the downstream handler can read it again) and
+// calls next.
+func Middleware(pipeline *Pipeline, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verdict, ok, err := scanRequest(r, pipeline)
+		if err != nil {
+			http.Error(w, "security check failed", http.StatusBadGateway)
+			return
+		}
+		if ok && verdict.IsAttack() {
+			writeJSONError(w, http.StatusForbidden, map[string]interface{}{
+				"error": "request blocked by security pipeline",
+				"label": verdict.Label,
+				"score": verdict.Score,
+			})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// MiddlewareWithStore wraps Middleware, additionally persisting every
+// scanned message's Verdict to store so security events survive past the
+// request that produced them.
+func MiddlewareWithStore(pipeline *Pipeline, store secevents.Store, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verdict, ok, err := scanRequest(r, pipeline)
+		if err != nil {
+			http.Error(w, "security check failed", http.StatusBadGateway)
+			return
+		}
+		if ok {
+			_ = store.Save(r.Context(), secevents.Event{
+				Identity:  r.RemoteAddr,
+				Label:     string(verdict.Label),
+				Score:     verdict.Score,
+				CreatedAt: time.Now(),
+			})
+			if verdict.IsAttack() {
+				writeJSONError(w, http.StatusForbidden, map[string]interface{}{
+					"error": "request blocked by security pipeline",
+					"label": verdict.Label,
+					"score": verdict.Score,
+				})
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// MiddlewareWithBruteForceDetection wraps Middleware with a
+// BruteForceDetector keyed on the caller's remote address: once an
+// identity crosses the detector's threshold, every subsequent request from
+// it is rejected with 429 Too Many Requests, even ones that individually
+// score as benign (a probing attacker often interleaves innocuous messages
+// between attempts).
+func MiddlewareWithBruteForceDetection(pipeline *Pipeline, detector *BruteForceDetector, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity := r.RemoteAddr
+		now := time.Now()
+
+		if detector.Count(identity, now) >= detector.threshold {
+			writeJSONError(w, http.StatusTooManyRequests, map[string]interface{}{
+				"error": "too many security violations from this client",
+			})
+			return
+		}
+
+		verdict, ok, err := scanRequest(r, pipeline)
+		if err != nil {
+			http.Error(w, "security check failed", http.StatusBadGateway)
+			return
+		}
+		if ok {
+			if detector.Observe(identity, verdict, now) {
+				writeJSONError(w, http.StatusTooManyRequests, map[string]interface{}{
+					"error": "brute-force / extraction attempt detected",
+				})
+				return
+			}
+			if verdict.IsAttack() {
+				writeJSONError(w, http.StatusForbidden, map[string]interface{}{
+					"error": "request blocked by security pipeline",
+					"label": verdict.Label,
+					"score": verdict.Score,
+				})
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
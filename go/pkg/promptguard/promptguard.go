@@ -0,0 +1,120 @@
+/*
+Package promptguard wraps meta-llama/llama-prompt-guard-2-86m behind a
+typed Client and Verdict, and a small pluggable Pipeline for running it
+alongside other checks (LlamaGuard, custom heuristics, ...) in order.
+
+05_prompt_guard.go and 07_prompt_guard.go both call this package for that
+request/response plumbing instead of keeping their own local types.
+*/
+package promptguard
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+const model = "meta-llama/llama-prompt-guard-2-86m"
+
+// Label is the coarse classification Prompt Guard returns.
+type Label string
+
+const (
+	LabelBenign    Label = "BENIGN"
+	LabelJailbreak Label = "JAILBREAK"
+	LabelInjection Label = "INJECTION"
+)
+
+// Verdict is the interpreted result of scanning one message.
+type Verdict struct {
+	Label Label
+	Score float64 // 0.0 (benign) .. 1.0 (attack)
+	Usage groqclient.Usage
+}
+
+// IsAttack reports whether the verdict indicates a jailbreak or injection
+// attempt, i.e. anything that isn't BENIGN.
+func (v Verdict) IsAttack() bool {
+	return v.Label != LabelBenign
+}
+
+// Client scans messages for jailbreak/injection attempts with Prompt Guard.
+type Client struct {
+	groq *groqclient.Client
+}
+
+// New creates a Client backed by a groqclient.Client pointed at baseURL.
+func New(baseURL, apiKey string) *Client {
+	return &Client{groq: groqclient.New(baseURL, apiKey)}
+}
+
+// Scan sends message to Prompt Guard and returns the interpreted Verdict.
+// Prompt Guard returns a bare probability score as text (e.g. "0.97"); a
+// score >= 0.5 is treated as an attack. It has no way to distinguish
+// jailbreak from injection on its own, so Label is set to LabelJailbreak
+// for any attack score — callers needing the finer-grained distinction
+// from the older text-label model should layer a heuristic (see
+// brute-force detection in [[chunk1-3]]) on top.
+func (c *Client) Scan(message string) (Verdict, error) {
+	resp, err := c.groq.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model: model,
+		Messages: []groqclient.Message{
+			groqclient.NewTextMessage("user", message),
+		},
+		MaxTokens: 100,
+	})
+	if err != nil {
+		return Verdict{}, err
+	}
+
+	scoreStr := strings.TrimSpace(resp.Choices[0].Message.Content)
+	score, _ := strconv.ParseFloat(scoreStr, 64)
+
+	label := LabelBenign
+	if score >= 0.5 {
+		label = LabelJailbreak
+	}
+
+	return Verdict{Label: label, Score: score, Usage: resp.Usage}, nil
+}
+
+// Check is a pipeline Stage backed by this Client's Scan.
+func (c *Client) Check(message string) (Verdict, error) {
+	return c.Scan(message)
+}
+
+// Stage is one step of a Pipeline: anything that can turn a message into a
+// Verdict. Client satisfies this via Check, so does any other scanner
+// (LlamaGuard, a custom regex heuristic, ...) with the same shape.
+type Stage interface {
+	Check(message string) (Verdict, error)
+}
+
+// Pipeline runs a message through an ordered list of Stages, stopping at
+// the first one that reports an attack.
+type Pipeline struct {
+	stages []Stage
+}
+
+// NewPipeline builds a Pipeline that runs stages in order.
+func NewPipeline(stages ...Stage) *Pipeline {
+	return &Pipeline{stages: stages}
+}
+
+// Run checks message against every stage in order, returning the first
+// attack Verdict it finds, or the last (benign) Verdict if none fire.
+func (p *Pipeline) Run(message string) (Verdict, error) {
+	var last Verdict
+	for _, stage := range p.stages {
+		verdict, err := stage.Check(message)
+		if err != nil {
+			return Verdict{}, err
+		}
+		last = verdict
+		if verdict.IsAttack() {
+			return verdict, nil
+		}
+	}
+	return last, nil
+}
@@ -0,0 +1,121 @@
+package prompttemplate
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+// Tool is one function an agent can call. It mirrors the shape
+// 10_tool_use.go hand-rolls per example, but as a reusable interface so a
+// compiled template's agent loop can be handed any set of tools.
+type Tool interface {
+	Name() string
+	Description() string
+	Parameters() map[string]interface{}
+	Execute(args map[string]interface{}) (string, error)
+}
+
+// ToolRegistry holds the tools one agent run may call, keyed by name.
+type ToolRegistry struct {
+	tools map[string]Tool
+}
+
+// NewToolRegistry builds a ToolRegistry from a set of tools.
+func NewToolRegistry(tools ...Tool) *ToolRegistry {
+	r := &ToolRegistry{tools: make(map[string]Tool, len(tools))}
+	for _, t := range tools {
+		r.tools[t.Name()] = t
+	}
+	return r
+}
+
+// Definitions returns the registry's tools in the shape
+// groqclient.ChatCompletionRequest.Tools expects.
+func (r *ToolRegistry) Definitions() []groqclient.ToolDefinition {
+	defs := make([]groqclient.ToolDefinition, 0, len(r.tools))
+	for _, t := range r.tools {
+		defs = append(defs, groqclient.ToolDefinition{
+			Type: "function",
+			Function: groqclient.FunctionDefinition{
+				Name:        t.Name(),
+				Description: t.Description(),
+				Parameters:  t.Parameters(),
+			},
+		})
+	}
+	return defs
+}
+
+// execute runs the named tool against a tool call's JSON-encoded
+// arguments, returning the string result to feed back to the model.
+func (r *ToolRegistry) execute(call groqclient.ToolCall) string {
+	t, ok := r.tools[call.Function.Name]
+	if !ok {
+		return fmt.Sprintf("error: unknown tool %q", call.Function.Name)
+	}
+
+	var args map[string]interface{}
+	if err := json.Unmarshal([]byte(call.Function.Arguments), &args); err != nil {
+		return fmt.Sprintf("error: invalid arguments for %q: %v", call.Function.Name, err)
+	}
+
+	result, err := t.Execute(args)
+	if err != nil {
+		return fmt.Sprintf("error: %v", err)
+	}
+	return result
+}
+
+// AgentResult is what RunAgent returns: the final answer, the full
+// transcript (useful for logging or feeding into the next template), and
+// how many model calls it took, counting the one that returned the answer.
+type AgentResult struct {
+	Answer   string
+	Messages []groqclient.Message
+	Steps    int
+}
+
+// RunAgent drives a tool-calling loop seeded by a compiled template's
+// rendered system and user prompts: it calls the model, and for as long as
+// the model keeps requesting tool calls instead of answering, executes
+// each one against tools and feeds the results back, up to maxSteps
+// rounds. This is what lets an Example 3-style rendered prompt drive a
+// multi-step agent the way 10_tool_use.go does by hand.
+func RunAgent(client *groqclient.Client, model, systemPrompt, userPrompt string, tools *ToolRegistry, maxSteps int) (AgentResult, error) {
+	messages := []groqclient.Message{
+		groqclient.NewTextMessage("system", systemPrompt),
+		groqclient.NewTextMessage("user", userPrompt),
+	}
+
+	for step := 0; step < maxSteps; step++ {
+		resp, err := client.CreateChatCompletion(groqclient.ChatCompletionRequest{
+			Model:      model,
+			Messages:   messages,
+			Tools:      tools.Definitions(),
+			ToolChoice: "auto",
+		})
+		if err != nil {
+			return AgentResult{}, fmt.Errorf("agent step %d: %w", step+1, err)
+		}
+
+		choice := resp.Choices[0]
+		if len(choice.Message.ToolCalls) == 0 {
+			return AgentResult{Answer: choice.Message.Content, Messages: messages, Steps: step + 1}, nil
+		}
+
+		messages = append(messages, groqclient.Message{
+			Role:      "assistant",
+			Content:   choice.Message.Content,
+			ToolCalls: choice.Message.ToolCalls,
+		})
+
+		for _, call := range choice.Message.ToolCalls {
+			result := tools.execute(call)
+			messages = append(messages, groqclient.NewToolResultMessage(call.ID, result))
+		}
+	}
+
+	return AgentResult{}, fmt.Errorf("agent did not converge within %d steps", maxSteps)
+}
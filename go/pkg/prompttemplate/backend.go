@@ -0,0 +1,184 @@
+package prompttemplate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+// CompletionOptions carries the sampling parameters a Config describes,
+// independent of which Backend ends up serving the request.
+type CompletionOptions struct {
+	Model       string
+	Temperature float64
+	MaxTokens   int
+}
+
+// Completion is a Backend-agnostic result: just the text, so templates
+// and callers don't need to know which provider answered.
+type Completion struct {
+	Text             string
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// Backend completes a rendered (system, user) prompt pair against one
+// model provider. The same compiled template can target any Backend by
+// swapping which one a Config resolves to.
+type Backend interface {
+	Complete(systemPrompt, userPrompt string, opts CompletionOptions) (Completion, error)
+}
+
+// GroqBackend targets any OpenAI-compatible /chat/completions endpoint —
+// Groq and OpenAI itself both fit this shape.
+type GroqBackend struct {
+	client *groqclient.Client
+}
+
+// NewGroqBackend creates a GroqBackend against baseURL (groqclient.GroqBaseURL
+// or an OpenAI-compatible equivalent).
+func NewGroqBackend(baseURL, apiKey string) *GroqBackend {
+	return &GroqBackend{client: groqclient.New(baseURL, apiKey)}
+}
+
+func (b *GroqBackend) Complete(systemPrompt, userPrompt string, opts CompletionOptions) (Completion, error) {
+	resp, err := b.client.CreateChatCompletion(groqclient.ChatCompletionRequest{
+		Model:       opts.Model,
+		Messages:    []groqclient.Message{groqclient.NewTextMessage("system", systemPrompt), groqclient.NewTextMessage("user", userPrompt)},
+		Temperature: opts.Temperature,
+		MaxTokens:   opts.MaxTokens,
+	})
+	if err != nil {
+		return Completion{}, err
+	}
+	return Completion{
+		Text:             resp.Choices[0].Message.Content,
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+	}, nil
+}
+
+// AnthropicBackend targets Anthropic's /v1/messages API, which has its own
+// request/response shape (top-level "system", x-api-key auth, a separate
+// "anthropic-version" header) distinct from the OpenAI-compatible one.
+type AnthropicBackend struct {
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewAnthropicBackend creates an AnthropicBackend authenticated with apiKey.
+func NewAnthropicBackend(apiKey string) *AnthropicBackend {
+	return &AnthropicBackend{apiKey: apiKey, httpClient: &http.Client{Timeout: 60 * time.Second}}
+}
+
+func (b *AnthropicBackend) Complete(systemPrompt, userPrompt string, opts CompletionOptions) (Completion, error) {
+	body, _ := json.Marshal(map[string]interface{}{
+		"model":      opts.Model,
+		"system":     systemPrompt,
+		"max_tokens": opts.MaxTokens,
+		"messages":   []map[string]string{{"role": "user", "content": userPrompt}},
+	})
+
+	req, err := http.NewRequest("POST", "https://api.anthropic.com/v1/messages", bytes.NewBuffer(body))
+	if err != nil {
+		return Completion{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", b.apiKey)
+	req.Header.Set("anthropic-version", "2023-06-01")
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return Completion{}, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Completion{}, err
+	}
+
+	var parsed struct {
+		Content []struct {
+			Text string `json:"text"`
+		} `json:"content"`
+		Usage struct {
+			InputTokens  int `json:"input_tokens"`
+			OutputTokens int `json:"output_tokens"`
+		} `json:"usage"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return Completion{}, fmt.Errorf("failed to parse Anthropic response: %w", err)
+	}
+	if parsed.Error != nil {
+		return Completion{}, fmt.Errorf("Anthropic API error: %s", parsed.Error.Message)
+	}
+
+	var text string
+	if len(parsed.Content) > 0 {
+		text = parsed.Content[0].Text
+	}
+	return Completion{Text: text, PromptTokens: parsed.Usage.InputTokens, CompletionTokens: parsed.Usage.OutputTokens}, nil
+}
+
+// OllamaBackend targets a locally-running Ollama server's /api/generate
+// endpoint — no API key, just a base URL, since Ollama runs on localhost.
+type OllamaBackend struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewOllamaBackend creates an OllamaBackend against baseURL (e.g.
+// "http://localhost:11434").
+func NewOllamaBackend(baseURL string) *OllamaBackend {
+	return &OllamaBackend{baseURL: baseURL, httpClient: &http.Client{Timeout: 120 * time.Second}}
+}
+
+func (b *OllamaBackend) Complete(systemPrompt, userPrompt string, opts CompletionOptions) (Completion, error) {
+	prompt := userPrompt
+	if systemPrompt != "" {
+		prompt = systemPrompt + "\n\n" + userPrompt
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"model":  opts.Model,
+		"prompt": prompt,
+		"stream": false,
+	})
+
+	req, err := http.NewRequest("POST", b.baseURL+"/api/generate", bytes.NewBuffer(body))
+	if err != nil {
+		return Completion{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return Completion{}, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Completion{}, err
+	}
+
+	var parsed struct {
+		Response   string `json:"response"`
+		PromptEval int    `json:"prompt_eval_count"`
+		EvalCount  int    `json:"eval_count"`
+	}
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return Completion{}, fmt.Errorf("failed to parse Ollama response: %w", err)
+	}
+
+	return Completion{Text: parsed.Response, PromptTokens: parsed.PromptEval, CompletionTokens: parsed.EvalCount}, nil
+}
@@ -0,0 +1,86 @@
+package prompttemplate
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Config bundles everything one prompt needs: which model to call, its
+// sampling parameters, and which template (by Registry name) renders it.
+// Config files live alongside templates as small YAML-subset documents,
+// e.g.:
+//
+//	model: openai/gpt-oss-20b
+//	template: essay_writer
+//	temperature: 0.7
+//	max_tokens: 2000
+//	reasoning_effort: medium
+type Config struct {
+	Model           string
+	Template        string
+	Temperature     float64
+	MaxTokens       int
+	ReasoningEffort string
+}
+
+// LoadConfig parses a prompt config file at path. Only a practical subset
+// of YAML is supported — flat "key: value" scalar pairs, one per line,
+// comments starting with "#" — which is all these config files need. This
+// avoids adding a third-party YAML dependency to a repo that otherwise
+// sticks to the standard library.
+func LoadConfig(path string) (Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to open config %s: %w", path, err)
+	}
+	defer f.Close()
+
+	cfg := Config{Temperature: 0.7, MaxTokens: 1024}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return Config{}, fmt.Errorf("invalid config line (expected \"key: value\"): %q", line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+
+		switch key {
+		case "model":
+			cfg.Model = value
+		case "template":
+			cfg.Template = value
+		case "reasoning_effort":
+			cfg.ReasoningEffort = value
+		case "temperature":
+			cfg.Temperature, err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				return Config{}, fmt.Errorf("invalid temperature %q: %w", value, err)
+			}
+		case "max_tokens":
+			cfg.MaxTokens, err = strconv.Atoi(value)
+			if err != nil {
+				return Config{}, fmt.Errorf("invalid max_tokens %q: %w", value, err)
+			}
+		default:
+			return Config{}, fmt.Errorf("unknown config key %q", key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Config{}, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	if cfg.Model == "" || cfg.Template == "" {
+		return Config{}, fmt.Errorf("config %s must set both \"model\" and \"template\"", path)
+	}
+	return cfg, nil
+}
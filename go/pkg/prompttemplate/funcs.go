@@ -0,0 +1,85 @@
+package prompttemplate
+
+import (
+	"strings"
+	"text/template"
+)
+
+// FuncMap returns the custom template functions every Registry template
+// gets access to — small prompt-engineering primitives that come up
+// repeatedly when hand-writing prompts: wrapping content in an XML-style
+// tag (a pattern several model providers recommend for delimiting
+// context), rendering a Go slice as a bullet list, and truncating long
+// user-supplied text so it doesn't blow the context window.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"xmlTag":     xmlTag,
+		"bulletList": bulletList,
+		"truncate":   truncate,
+		"fewShot":    fewShot,
+		"indent":     indent,
+	}
+}
+
+// xmlTag wraps content in <name>...</name>, the delimiter style Anthropic
+// and others recommend for marking off a block of context in a prompt.
+func xmlTag(name, content string) string {
+	return "<" + name + ">\n" + content + "\n</" + name + ">"
+}
+
+// bulletList renders items as a "- item" list, one per line.
+func bulletList(items []string) string {
+	var b strings.Builder
+	for _, item := range items {
+		b.WriteString("- ")
+		b.WriteString(item)
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// truncate shortens s to at most n runes, appending "..." if it was cut.
+func truncate(n int, s string) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "..."
+}
+
+// fewShotExample is one input/output pair for a few-shot prompt.
+type fewShotExample struct {
+	Input  string
+	Output string
+}
+
+// fewShot renders a slice of {Input, Output} pairs as the
+// "Input: ...\nOutput: ...\n" blocks conventionally used for few-shot
+// prompting.
+func fewShot(examples []fewShotExample) string {
+	var b strings.Builder
+	for i, ex := range examples {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("Input: ")
+		b.WriteString(ex.Input)
+		b.WriteString("\nOutput: ")
+		b.WriteString(ex.Output)
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// indent prefixes every line of s with n spaces, useful for nesting a
+// rendered partial inside another block without breaking its formatting.
+func indent(n int, s string) string {
+	prefix := strings.Repeat(" ", n)
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
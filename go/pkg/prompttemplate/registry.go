@@ -0,0 +1,84 @@
+/*
+Package prompttemplate extends the single-file template compilation in
+03_prompt_template.go into a small system: a Registry of named templates
+that can extend one another, include partials, and override named blocks,
+the way Go's html/template "define"/"block"/"template" actions work but
+using this repo's [[ ]] delimiter convention.
+*/
+package prompttemplate
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// Delims is the custom delimiter pair every template in this repo uses
+// instead of "{{" / "}}", so prompt bodies can contain literal braces
+// (JSON examples, code snippets) without escaping.
+var Delims = [2]string{"[[", "]]"}
+
+// Registry loads a directory of template files and compiles them together,
+// so one template can reference another via [[template "name" .]],
+// override a [[block "name" .]] ... [[end]] defined by a parent, and share
+// partials.
+type Registry struct {
+	dir  string
+	tmpl *template.Template
+}
+
+// NewRegistry loads every *.tmpl file in dir into one compiled template
+// set. Files are named by their base name without extension, e.g.
+// base.tmpl is addressable as "base"; subdirectories of dir are not
+// searched.
+func NewRegistry(dir string) (*Registry, error) {
+	root := template.New("root").Delims(Delims[0], Delims[1]).Funcs(FuncMap())
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates in %s: %w", dir, err)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no *.tmpl files found in %s", dir)
+	}
+
+	for _, path := range matches {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		name := filepath.Base(path)
+		name = name[:len(name)-len(filepath.Ext(name))]
+
+		if _, err := root.New(name).Parse(string(content)); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+	}
+
+	return &Registry{dir: dir, tmpl: root}, nil
+}
+
+// Render executes the named template with data, resolving any
+// [[template "..."]] includes and [[block]] overrides against the rest of
+// the registry.
+func (r *Registry) Render(name string, data interface{}) (string, error) {
+	var buf bytes.Buffer
+	if err := r.tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", fmt.Errorf("failed to render template %q: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// Names returns the names of every template loaded into the registry,
+// useful for listing what's available.
+func (r *Registry) Names() []string {
+	var names []string
+	for _, t := range r.tmpl.Templates() {
+		if t.Name() != "root" {
+			names = append(names, t.Name())
+		}
+	}
+	return names
+}
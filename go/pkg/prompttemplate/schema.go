@@ -0,0 +1,132 @@
+package prompttemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Schema is a minimal JSON-Schema-style validator for the variables a
+// template expects: object/array/string/number/boolean/integer types,
+// "required" properties, and "$ref" pointers into the document's own
+// "definitions" (the one feature these config-driven templates actually
+// need — sharing a variable shape between two prompts).
+type Schema struct {
+	root map[string]interface{}
+}
+
+// ParseSchema parses a JSON Schema document.
+func ParseSchema(raw []byte) (*Schema, error) {
+	var root map[string]interface{}
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+	return &Schema{root: root}, nil
+}
+
+// Validate checks data against the schema's root definition, resolving
+// any "$ref" pointers first.
+func (s *Schema) Validate(data map[string]interface{}) error {
+	return s.validateNode(s.root, data, "$")
+}
+
+// resolveRef follows a "#/definitions/Name" or "#/$defs/Name" pointer to
+// the referenced schema node.
+func (s *Schema) resolveRef(ref string) (map[string]interface{}, error) {
+	ref = strings.TrimPrefix(ref, "#/")
+	parts := strings.Split(ref, "/")
+
+	var node interface{} = s.root
+	for _, part := range parts {
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot resolve $ref %q: %q is not an object", ref, part)
+		}
+		node, ok = m[part]
+		if !ok {
+			return nil, fmt.Errorf("cannot resolve $ref %q: %q not found", ref, part)
+		}
+	}
+
+	resolved, ok := node.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cannot resolve $ref %q: target is not an object", ref)
+	}
+	return resolved, nil
+}
+
+func (s *Schema) validateNode(node map[string]interface{}, value interface{}, path string) error {
+	if ref, ok := node["$ref"].(string); ok {
+		resolved, err := s.resolveRef(ref)
+		if err != nil {
+			return err
+		}
+		return s.validateNode(resolved, value, path)
+	}
+
+	schemaType, _ := node["type"].(string)
+
+	switch schemaType {
+	case "object", "":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected an object, got %T", path, value)
+		}
+
+		for _, req := range toStringSlice(node["required"]) {
+			if _, present := obj[req]; !present {
+				return fmt.Errorf("%s: missing required field %q", path, req)
+			}
+		}
+
+		props, _ := node["properties"].(map[string]interface{})
+		for name, propSchemaRaw := range props {
+			propValue, present := obj[name]
+			if !present {
+				continue
+			}
+			propSchema, ok := propSchemaRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := s.validateNode(propSchema, propValue, path+"."+name); err != nil {
+				return err
+			}
+		}
+
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: expected a string, got %T", path, value)
+		}
+	case "number", "integer":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s: expected a number, got %T", path, value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected a boolean, got %T", path, value)
+		}
+	case "array":
+		if _, ok := value.([]interface{}); !ok {
+			return fmt.Errorf("%s: expected an array, got %T", path, value)
+		}
+	default:
+		return fmt.Errorf("%s: unsupported schema type %q", path, schemaType)
+	}
+
+	return nil
+}
+
+func toStringSlice(v interface{}) []string {
+	items, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+package prompttemplate
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+)
+
+// Pricing is a model's per-million-token price, used to turn a streamed
+// response's usage frame into a dollar figure.
+type Pricing struct {
+	InputPerMillion  float64
+	OutputPerMillion float64
+}
+
+// StreamResult is what StreamAndAccount returns once a stream completes:
+// the full assembled text plus the usage/cost accounting a non-streaming
+// call would have given for free.
+type StreamResult struct {
+	Text  string
+	Usage groqclient.Usage
+	Cost  float64
+}
+
+// StreamAndAccount streams req and writes each content delta to w as it
+// arrives (so callers get the same incremental "typing" UI as a
+// non-template streaming call), then returns the assembled text alongside
+// token/cost accounting from the stream's final usage frame — the piece
+// that's easy to lose when switching an example from a single
+// CreateChatCompletion call to streaming.
+func StreamAndAccount(client *groqclient.Client, req groqclient.ChatCompletionRequest, price Pricing, w io.Writer) (StreamResult, error) {
+	events, err := client.CallReasoningStream(req)
+	if err != nil {
+		return StreamResult{}, err
+	}
+
+	var result StreamResult
+	var text []byte
+
+	for event := range events {
+		switch event.Type {
+		case groqclient.EventContentDelta:
+			text = append(text, event.Content...)
+			fmt.Fprint(w, event.Content)
+		case groqclient.EventUsage:
+			result.Usage = *event.Usage
+		case groqclient.EventDone:
+			if event.Err != nil {
+				return StreamResult{}, event.Err
+			}
+		}
+	}
+
+	result.Text = string(text)
+	result.Cost = float64(result.Usage.PromptTokens)/1_000_000*price.InputPerMillion +
+		float64(result.Usage.CompletionTokens)/1_000_000*price.OutputPerMillion
+	return result, nil
+}
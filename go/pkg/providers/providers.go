@@ -0,0 +1,19 @@
+/*
+Package providers routes a chat request across an ordered list of
+pkg/llm.Provider backends with failover, instead of a caller picking one
+provider (pkg/completer.New) and having no fallback when it's down.
+
+Router reuses llm.Provider as its client interface rather than defining a
+new one - every backend this repo supports (Groq, SambaNova via
+OpenAIProvider, Anthropic, ...) already implements it, so wrapping them in
+Named is all a caller needs to do.
+
+Retry/failover detection (see isRetryable in router.go) is based on
+groqclient.HTTPError's status code, since that's the only typed error
+surface this repo's OpenAI-compatible providers expose; AnthropicProvider
+wraps failures in plain fmt.Errorf without a status code, so a 429/5xx
+from Anthropic isn't distinguishable from a permanent failure today - only
+a context deadline is. A future AnthropicProvider change to return a
+comparable typed error would close that gap without any change here.
+*/
+package providers
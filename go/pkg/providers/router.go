@@ -0,0 +1,193 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/patdeg/ai101/go/pkg/groqclient"
+	"github.com/patdeg/ai101/go/pkg/llm"
+)
+
+// Policy selects how Router orders and tries its providers.
+type Policy string
+
+const (
+	// PolicyFirstSuccess tries providers in the order they were given,
+	// stopping at the first one that returns without a retryable error.
+	PolicyFirstSuccess Policy = "first-success"
+	// PolicyRace starts every provider concurrently and returns the
+	// first successful response, cancelling the rest.
+	PolicyRace Policy = "race"
+	// PolicyCheapestFirst behaves like PolicyFirstSuccess but tries
+	// providers ordered by ascending Named.CostPerMillionOutputTokens
+	// instead of the order they were given.
+	PolicyCheapestFirst Policy = "cheapest-first"
+)
+
+// Named pairs an llm.Provider with the name Router reports it under and
+// the cost PolicyCheapestFirst orders by.
+type Named struct {
+	Name     string
+	Provider llm.Provider
+	// CostPerMillionOutputTokens is only consulted by PolicyCheapestFirst;
+	// leave it zero if the policy in use doesn't need it.
+	CostPerMillionOutputTokens float64
+}
+
+// Stats accumulates one provider's outcomes across every Router.Chat call
+// that attempted it.
+type Stats struct {
+	Requests     int
+	Successes    int
+	Failures     int
+	TotalLatency time.Duration
+	TotalTokens  int
+}
+
+// Router tries an ordered list of Named providers per Policy, falling
+// back to the next one on a retryable error (see isRetryable), and
+// records each attempt's latency and token usage for Stats.
+type Router struct {
+	mu        sync.Mutex
+	providers []Named
+	policy    Policy
+	stats     map[string]*Stats
+}
+
+// NewRouter builds a Router over providers, tried according to policy.
+func NewRouter(policy Policy, providers ...Named) *Router {
+	stats := make(map[string]*Stats, len(providers))
+	for _, p := range providers {
+		stats[p.Name] = &Stats{}
+	}
+	return &Router{providers: providers, policy: policy, stats: stats}
+}
+
+// Chat runs req against r's providers per r.policy, returning the
+// successful response along with the name of the provider that served
+// it. If every provider fails, Chat returns the last error.
+func (r *Router) Chat(ctx context.Context, req llm.ChatRequest) (llm.ChatResponse, string, error) {
+	order := r.orderedProviders()
+	if r.policy == PolicyRace {
+		return r.race(ctx, req, order)
+	}
+	return r.firstSuccess(ctx, req, order)
+}
+
+// Stats returns a snapshot of every provider's accumulated Stats.
+func (r *Router) Stats() map[string]Stats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make(map[string]Stats, len(r.stats))
+	for name, s := range r.stats {
+		out[name] = *s
+	}
+	return out
+}
+
+// orderedProviders returns r.providers in the order r.policy tries them.
+func (r *Router) orderedProviders() []Named {
+	if r.policy != PolicyCheapestFirst {
+		return r.providers
+	}
+	ordered := make([]Named, len(r.providers))
+	copy(ordered, r.providers)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].CostPerMillionOutputTokens < ordered[j].CostPerMillionOutputTokens
+	})
+	return ordered
+}
+
+// firstSuccess tries order in sequence, returning the first response
+// that doesn't fail with a retryable error.
+func (r *Router) firstSuccess(ctx context.Context, req llm.ChatRequest, order []Named) (llm.ChatResponse, string, error) {
+	var lastErr error
+	for _, p := range order {
+		resp, err := r.attempt(ctx, p, req)
+		if err == nil {
+			return resp, p.Name, nil
+		}
+		lastErr = err
+		if !isRetryable(err) {
+			return llm.ChatResponse{}, p.Name, err
+		}
+	}
+	return llm.ChatResponse{}, "", fmt.Errorf("all providers failed, last error: %w", lastErr)
+}
+
+// raceResult is one provider's outcome in Router.race.
+type raceResult struct {
+	name string
+	resp llm.ChatResponse
+	err  error
+}
+
+// race starts every provider in order concurrently and returns the first
+// successful response, cancelling the rest via ctx.
+func (r *Router) race(ctx context.Context, req llm.ChatRequest, order []Named) (llm.ChatResponse, string, error) {
+	raceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan raceResult, len(order))
+	for _, p := range order {
+		p := p
+		go func() {
+			resp, err := r.attempt(raceCtx, p, req)
+			results <- raceResult{name: p.Name, resp: resp, err: err}
+		}()
+	}
+
+	var lastErr error
+	for range order {
+		result := <-results
+		if result.err == nil {
+			return result.resp, result.name, nil
+		}
+		lastErr = result.err
+	}
+	return llm.ChatResponse{}, "", fmt.Errorf("all providers failed, last error: %w", lastErr)
+}
+
+// attempt calls p.Provider.Chat, timing it and recording the outcome in
+// r.stats regardless of whether it succeeds.
+func (r *Router) attempt(ctx context.Context, p Named, req llm.ChatRequest) (llm.ChatResponse, error) {
+	start := time.Now()
+	resp, err := p.Provider.Chat(ctx, req)
+	latency := time.Since(start)
+
+	r.mu.Lock()
+	stats := r.stats[p.Name]
+	stats.Requests++
+	stats.TotalLatency += latency
+	if err != nil {
+		stats.Failures++
+	} else {
+		stats.Successes++
+		stats.TotalTokens += resp.Usage.Total
+	}
+	r.mu.Unlock()
+
+	return resp, err
+}
+
+// isRetryable reports whether err is the kind of failure Router should
+// fail over on: a context deadline, or a groqclient.HTTPError carrying a
+// 429 (rate limited) or 5xx (server error) status. Any other error -
+// including a plain non-2xx from a provider without a typed HTTPError,
+// such as AnthropicProvider today - is treated as permanent, since
+// retrying a malformed request against a different provider wouldn't
+// help.
+func isRetryable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var httpErr *groqclient.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == 429 || httpErr.StatusCode >= 500
+	}
+	return false
+}
@@ -0,0 +1,362 @@
+/*
+Package safetycache wraps an image-safety check (e.g. LlamaGuard via
+pkg/groqclient) with a content-addressed cache, so that checking the same
+image with the same model twice only calls the API once.
+
+Cache key is sha256(image bytes) + model ID, so upgrading the safety model
+naturally invalidates old verdicts instead of returning stale results. On
+top of that exact-match key, the cache also keeps each entry's pkg/phash
+perceptual hash, so a re-saved, re-compressed, or lightly-cropped copy of
+an already-checked image — which has a completely different sha256 — can
+still be recognized as "the same picture" and served from cache instead of
+re-checked.
+
+Layout on disk: <dir>/<sha256>-<model>.json, each holding a CachedResult
+("/" in the model ID is written as "_" so the file stays directly in dir).
+An in-memory LRU sits in front of the disk tier; perceptual-hash matching
+searches that in-memory tier first and then scans this model's entries on
+disk.
+*/
+package safetycache
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/patdeg/ai101/go/pkg/phash"
+)
+
+// SafetyResult is the verdict a Checker produces for one image.
+type SafetyResult struct {
+	IsSafe      bool   `json:"is_safe"`
+	Category    string `json:"category"`
+	Explanation string `json:"explanation"`
+}
+
+// CachedResult is what's persisted to disk (and kept in the LRU): the
+// verdict plus enough metadata to validate and expire the entry.
+type CachedResult struct {
+	Result    SafetyResult `json:"result"`
+	Model     string       `json:"model"`
+	CheckedAt time.Time    `json:"checked_at"`
+	PHash     phash.Hash   `json:"phash,omitempty"`
+}
+
+// Checker calls out to the real safety model. It's the thing being cached.
+type Checker func(imageData []byte, model string) (SafetyResult, error)
+
+// Cache wraps a Checker with an in-memory LRU tier in front of an on-disk
+// content-addressed store.
+type Cache struct {
+	dir                   string
+	model                 string
+	ttl                   time.Duration
+	check                 Checker
+	pricePerMillionTokens float64
+	perceptualThreshold   int
+
+	mu   sync.Mutex
+	lru  *list.List               // front = most recently used
+	idx  map[string]*list.Element // key -> lru element
+	data map[string]CachedResult  // key -> cached entry
+	cap  int
+
+	stats Stats
+}
+
+// defaultPerceptualThreshold is the maximum Hamming distance, out of 64
+// bits, for two images to be treated as "the same picture" for caching
+// purposes. 5 tolerates re-compression and minor edits without matching
+// genuinely different images.
+const defaultPerceptualThreshold = 5
+
+// Stats tracks cache effectiveness for the `safetycache stats` CLI.
+type Stats struct {
+	Hits           int
+	PerceptualHits int // subset of Hits served by near-duplicate match rather than an exact key
+	Misses         int
+	TokensPerCall  int // approximate tokens a safety call consumes, for $ saved estimates
+}
+
+// HitRatio returns hits / (hits + misses), or 0 if nothing has been checked.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// New creates a Cache that persists entries under dir, treats entries older
+// than ttl as expired, keeps at most lruSize entries in memory, and calls
+// check on a miss. A ttl of 0 means entries never expire.
+func New(dir, model string, ttl time.Duration, lruSize int, check Checker) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache dir: %w", err)
+	}
+	c := &Cache{
+		dir:                   dir,
+		model:                 model,
+		ttl:                   ttl,
+		check:                 check,
+		pricePerMillionTokens: 0.20, // LlamaGuard pricing: $0.20 per 1M tokens
+		perceptualThreshold:   defaultPerceptualThreshold,
+		lru:                   list.New(),
+		idx:                   make(map[string]*list.Element),
+		data:                  make(map[string]CachedResult),
+		cap:                   lruSize,
+		stats:                 Stats{TokensPerCall: 120}, // rough prompt+completion tokens per safety check
+	}
+	c.loadStats()
+	return c, nil
+}
+
+func (c *Cache) statsPath() string {
+	return filepath.Join(c.dir, "stats.json")
+}
+
+// loadStats restores hit/miss counters persisted by a previous process, so
+// `safetycache stats` reflects cumulative usage rather than just this run.
+func (c *Cache) loadStats() {
+	raw, err := os.ReadFile(c.statsPath())
+	if err != nil {
+		return
+	}
+	var saved Stats
+	if json.Unmarshal(raw, &saved) == nil {
+		c.stats = saved
+	}
+}
+
+// saveStats persists the current counters; callers hold c.mu.
+func (c *Cache) saveStats() {
+	raw, err := json.MarshalIndent(c.stats, "", "  ")
+	if err == nil {
+		_ = os.WriteFile(c.statsPath(), raw, 0644)
+	}
+}
+
+// Key returns the cache key for imageData under the cache's configured
+// model: sha256(imageData) combined with the model ID.
+func (c *Cache) Key(imageData []byte) string {
+	sum := sha256.Sum256(imageData)
+	return hex.EncodeToString(sum[:]) + "-" + strings.ReplaceAll(c.model, "/", "_")
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// Check returns the safety verdict for imageData, using the cache unless
+// forceRefresh is set or no cached entry exists / it has expired. Besides
+// the exact sha256 key, a near-identical image (same perceptual hash
+// within the cache's threshold) also counts as a hit.
+func (c *Cache) Check(imageData []byte, forceRefresh bool) (SafetyResult, error) {
+	key := c.Key(imageData)
+	hash, hashErr := phash.Compute(bytes.NewReader(imageData))
+
+	if !forceRefresh {
+		if result, ok := c.lookup(key); ok {
+			c.mu.Lock()
+			c.stats.Hits++
+			c.saveStats()
+			c.mu.Unlock()
+			return result.Result, nil
+		}
+
+		if hashErr == nil {
+			if result, ok := c.lookupSimilar(hash); ok {
+				c.mu.Lock()
+				c.stats.Hits++
+				c.stats.PerceptualHits++
+				c.saveStats()
+				c.mu.Unlock()
+				return result.Result, nil
+			}
+		}
+	}
+
+	c.mu.Lock()
+	c.stats.Misses++
+	c.saveStats()
+	c.mu.Unlock()
+
+	result, err := c.check(imageData, c.model)
+	if err != nil {
+		return SafetyResult{}, err
+	}
+
+	entry := CachedResult{Result: result, Model: c.model, CheckedAt: checkedAtNow()}
+	if hashErr == nil {
+		entry.PHash = hash
+	}
+	c.store(key, entry)
+	return result, nil
+}
+
+// lookupSimilar looks for an unexpired entry under the same model whose
+// perceptual hash is within perceptualThreshold bits of hash: first the
+// in-memory LRU tier, then (since a one-shot CLI process starts with an
+// empty LRU) the on-disk store for this model.
+func (c *Cache) lookupSimilar(hash phash.Hash) (CachedResult, bool) {
+	if entry, key, ok := c.lookupSimilarInMemory(hash); ok {
+		c.mu.Lock()
+		if elem, ok := c.idx[key]; ok {
+			c.lru.MoveToFront(elem)
+		}
+		c.mu.Unlock()
+		return entry, true
+	}
+
+	if entry, key, ok := c.lookupSimilarOnDisk(hash); ok {
+		c.promote(key, entry)
+		return entry, true
+	}
+
+	return CachedResult{}, false
+}
+
+func (c *Cache) lookupSimilarInMemory(hash phash.Hash) (CachedResult, string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.data {
+		if entry.Model != c.model || entry.PHash == 0 || c.expired(entry) {
+			continue
+		}
+		if phash.Distance(hash, entry.PHash) <= c.perceptualThreshold {
+			return entry, key, true
+		}
+	}
+	return CachedResult{}, "", false
+}
+
+// lookupSimilarOnDisk scans every cached entry for this model on disk,
+// looking for a perceptual-hash match. This is O(n) in the number of
+// cached images, which is acceptable for the educational scale this
+// package targets; a production cache would maintain a separate
+// hash -> key index instead of re-reading every file.
+func (c *Cache) lookupSimilarOnDisk(hash phash.Hash) (CachedResult, string, bool) {
+	suffix := "-" + strings.ReplaceAll(c.model, "/", "_") + ".json"
+	matches, err := filepath.Glob(filepath.Join(c.dir, "*"+suffix))
+	if err != nil {
+		return CachedResult{}, "", false
+	}
+
+	for _, path := range matches {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var entry CachedResult
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			continue
+		}
+		if entry.PHash == 0 || c.expired(entry) {
+			continue
+		}
+		if phash.Distance(hash, entry.PHash) <= c.perceptualThreshold {
+			key := filepath.Base(path)
+			key = key[:len(key)-len(".json")]
+			return entry, key, true
+		}
+	}
+	return CachedResult{}, "", false
+}
+
+// lookup checks the in-memory LRU first, then falls back to disk,
+// returning ok=false if there is no entry or it has expired.
+func (c *Cache) lookup(key string) (CachedResult, bool) {
+	c.mu.Lock()
+	if elem, ok := c.idx[key]; ok {
+		c.lru.MoveToFront(elem)
+		entry := c.data[key]
+		c.mu.Unlock()
+		if c.expired(entry) {
+			return CachedResult{}, false
+		}
+		return entry, true
+	}
+	c.mu.Unlock()
+
+	raw, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return CachedResult{}, false
+	}
+	var entry CachedResult
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return CachedResult{}, false
+	}
+	if c.expired(entry) {
+		return CachedResult{}, false
+	}
+
+	c.promote(key, entry)
+	return entry, true
+}
+
+func (c *Cache) expired(entry CachedResult) bool {
+	if c.ttl <= 0 {
+		return false
+	}
+	return checkedAtNow().Sub(entry.CheckedAt) > c.ttl
+}
+
+// store writes entry to disk and promotes it into the in-memory LRU,
+// evicting the least-recently-used entry if the LRU is full.
+func (c *Cache) store(key string, entry CachedResult) {
+	raw, err := json.MarshalIndent(entry, "", "  ")
+	if err == nil {
+		_ = os.WriteFile(c.path(key), raw, 0644)
+	}
+	c.promote(key, entry)
+}
+
+func (c *Cache) promote(key string, entry CachedResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.data[key] = entry
+	if elem, ok := c.idx[key]; ok {
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.idx[key] = c.lru.PushFront(key)
+	if c.cap > 0 && c.lru.Len() > c.cap {
+		oldest := c.lru.Back()
+		if oldest != nil {
+			evictedKey := oldest.Value.(string)
+			c.lru.Remove(oldest)
+			delete(c.idx, evictedKey)
+			delete(c.data, evictedKey)
+		}
+	}
+}
+
+// Stats returns a snapshot of hit/miss counters.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// EstimatedSavings approximates the dollar amount saved by cache hits,
+// using TokensPerCall and the configured per-million-token price.
+func (c *Cache) EstimatedSavings() float64 {
+	s := c.Stats()
+	return float64(s.Hits*s.TokensPerCall) / 1_000_000 * c.pricePerMillionTokens
+}
+
+// checkedAtNow is a seam so tests could stub time; production always uses
+// the wall clock.
+var checkedAtNow = time.Now
@@ -0,0 +1,96 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+)
+
+// DockerSandbox runs code inside a throwaway container: --rm, a
+// read-only bind mount of the workdir, --network=none unless
+// Limits.AllowNetwork, and --memory/--cpus derived from Limits.
+type DockerSandbox struct {
+	// Images maps a language to the image Run invokes, e.g.
+	// "python:3.12-slim" for language "python".
+	Images map[string]string
+}
+
+// NewDockerSandbox builds a DockerSandbox with this repo's default image
+// per supported language.
+func NewDockerSandbox() *DockerSandbox {
+	return &DockerSandbox{Images: map[string]string{
+		"python": "python:3.12-slim",
+	}}
+}
+
+// Run implements Sandbox.
+func (d *DockerSandbox) Run(ctx context.Context, language, code string, limits Limits) (Result, error) {
+	image, ok := d.Images[language]
+	if !ok {
+		return Result{}, fmt.Errorf("no Docker image configured for language %q", language)
+	}
+
+	workdir, err := os.MkdirTemp("", "sandbox-docker-")
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to create workdir: %w", err)
+	}
+	defer os.RemoveAll(workdir)
+
+	scriptPath := filepath.Join(workdir, "main.py")
+	if err := os.WriteFile(scriptPath, []byte(code), 0644); err != nil {
+		return Result{}, fmt.Errorf("failed to write script: %w", err)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, time.Duration(limits.WallClockSeconds)*time.Second)
+	defer cancel()
+
+	// Docker has no direct CPU-time ulimit flag, so CPUSeconds is
+	// approximated as a core fraction: just enough of a core that
+	// limits.CPUSeconds of CPU time fits within the WallClockSeconds
+	// timeout that actually kills the container.
+	cpuFraction := 1.0
+	if limits.WallClockSeconds > 0 {
+		cpuFraction = float64(limits.CPUSeconds) / float64(limits.WallClockSeconds)
+	}
+	if cpuFraction <= 0 {
+		cpuFraction = 1.0
+	}
+
+	args := []string{
+		"run", "--rm",
+		"--workdir", "/sandbox",
+		"--volume", workdir + ":/sandbox:ro",
+		"--memory", fmt.Sprintf("%dm", limits.MemoryMB),
+		"--cpus", fmt.Sprintf("%.2f", cpuFraction),
+	}
+	if !limits.AllowNetwork {
+		args = append(args, "--network", "none")
+	}
+	args = append(args, image, "python", "/sandbox/main.py")
+
+	cmd := exec.CommandContext(runCtx, "docker", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	exitCode := 0
+	if err := cmd.Run(); err != nil {
+		exitErr, ok := err.(*exec.ExitError)
+		if !ok {
+			return Result{}, fmt.Errorf("failed to run docker sandbox: %w", err)
+		}
+		exitCode = exitErr.ExitCode()
+	}
+
+	return Result{
+		Type:     "code_interpreter",
+		Input:    code,
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+		ExitCode: exitCode,
+	}, nil
+}
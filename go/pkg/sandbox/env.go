@@ -0,0 +1,28 @@
+package sandbox
+
+import (
+	"fmt"
+	"os"
+)
+
+// EnvSandboxBackend selects which Sandbox backend FromEnv builds: docker
+// (default) or runsc.
+const EnvSandboxBackend = "SANDBOX_BACKEND"
+
+// FromEnv builds the Sandbox named by SANDBOX_BACKEND, defaulting to
+// Docker if unset.
+func FromEnv() (Sandbox, error) {
+	backend := os.Getenv(EnvSandboxBackend)
+	if backend == "" {
+		backend = "docker"
+	}
+
+	switch backend {
+	case "docker":
+		return NewDockerSandbox(), nil
+	case "runsc":
+		return NewRunscSandbox(), nil
+	default:
+		return nil, fmt.Errorf("unknown %s %q (want docker or runsc)", EnvSandboxBackend, backend)
+	}
+}
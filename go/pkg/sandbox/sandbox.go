@@ -0,0 +1,52 @@
+/*
+Package sandbox runs model-produced code locally when a hosted code-
+execution tool - like Groq's code_interpreter, used by 12_code_execution.go
+- is unavailable or disabled. Exercise 12 falls back to it when
+LOCAL_SANDBOX=1 is set: it parses any <code> blocks out of the model's
+answer, runs each one through a Sandbox, and feeds the captured output
+back to the model in a follow-up turn.
+
+Two backends implement the Sandbox interface: Docker (docker.go) and
+gVisor's runsc (runsc.go, the stronger isolation option via `runsc do`).
+Both take the same Limits (the runsc backend enforces only
+WallClockSeconds of them; see runsc.go), mount the workdir read-only, and deny
+network access unless explicitly allowed. FromEnv (env.go) selects
+between them, mirroring pkg/notify's and pkg/audit's FromEnv pattern.
+*/
+package sandbox
+
+import "context"
+
+// Limits bounds what a single Sandbox run may consume.
+type Limits struct {
+	CPUSeconds       int
+	MemoryMB         int
+	WallClockSeconds int
+	// AllowNetwork opts into network access; both backends deny it by
+	// default.
+	AllowNetwork bool
+}
+
+// DefaultLimits is a conservative budget for short model-produced
+// scripts.
+var DefaultLimits = Limits{
+	CPUSeconds:       5,
+	MemoryMB:         256,
+	WallClockSeconds: 10,
+}
+
+// Result is one code run's outcome, shaped to match the executed_tools
+// entry 12_code_execution.go already prints for the hosted
+// code_interpreter tool, so both paths render the same way.
+type Result struct {
+	Type     string `json:"type"`
+	Input    string `json:"input"`
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+	ExitCode int    `json:"exit_code"`
+}
+
+// Sandbox runs one piece of code in isolation and reports what happened.
+type Sandbox interface {
+	Run(ctx context.Context, language, code string, limits Limits) (Result, error)
+}
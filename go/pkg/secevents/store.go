@@ -0,0 +1,133 @@
+/*
+Package secevents persists the security verdicts produced by
+pkg/promptguard (and LlamaGuard checks generally) to a pluggable Store,
+instead of the fmt.Println logging scattered across the examples.
+
+Store follows the repository pattern popularized by bun/GORM, but is
+implemented against database/sql directly to keep this repo's
+stdlib-only convention — swap SQLStore's driver import for any
+database/sql-compatible driver (sqlite3, postgres, ...) without touching
+the rest of the package.
+*/
+package secevents
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Event is one security-pipeline decision worth keeping a record of.
+type Event struct {
+	ID        int64
+	Identity  string // caller IP, API key, or session ID
+	Label     string // e.g. "JAILBREAK", "INJECTION", "BENIGN"
+	Score     float64
+	Message   string
+	CreatedAt time.Time
+}
+
+// Store persists and queries Events. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	Save(ctx context.Context, event Event) error
+	ListByIdentity(ctx context.Context, identity string, limit int) ([]Event, error)
+}
+
+// MemoryStore is an in-process Store, useful for tests and local demos.
+type MemoryStore struct {
+	mu     sync.Mutex
+	nextID int64
+	events []Event
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Save appends event to the in-memory log, assigning it an ID.
+func (s *MemoryStore) Save(_ context.Context, event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	event.ID = s.nextID
+	s.events = append(s.events, event)
+	return nil
+}
+
+// ListByIdentity returns up to limit most-recent events for identity.
+func (s *MemoryStore) ListByIdentity(_ context.Context, identity string, limit int) ([]Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matched []Event
+	for i := len(s.events) - 1; i >= 0 && len(matched) < limit; i-- {
+		if s.events[i].Identity == identity {
+			matched = append(matched, s.events[i])
+		}
+	}
+	return matched, nil
+}
+
+// SQLStore persists Events to any database/sql driver. Callers are
+// responsible for opening db against the schema in Schema().
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore wraps an already-opened *sql.DB.
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+// Schema returns the DDL for the security_events table SQLStore expects.
+// Callers run this once against their database of choice before using
+// SQLStore.
+func Schema() string {
+	return `CREATE TABLE IF NOT EXISTS security_events (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	identity   TEXT NOT NULL,
+	label      TEXT NOT NULL,
+	score      REAL NOT NULL,
+	message    TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL
+)`
+}
+
+// Save inserts event into security_events.
+func (s *SQLStore) Save(ctx context.Context, event Event) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO security_events (identity, label, score, message, created_at) VALUES (?, ?, ?, ?, ?)`,
+		event.Identity, event.Label, event.Score, event.Message, event.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save security event: %w", err)
+	}
+	return nil
+}
+
+// ListByIdentity returns up to limit most-recent events for identity.
+func (s *SQLStore) ListByIdentity(ctx context.Context, identity string, limit int) ([]Event, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, identity, label, score, message, created_at FROM security_events
+		 WHERE identity = ? ORDER BY created_at DESC LIMIT ?`,
+		identity, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query security events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []Event
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(&e.ID, &e.Identity, &e.Label, &e.Score, &e.Message, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan security event: %w", err)
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
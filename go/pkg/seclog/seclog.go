@@ -0,0 +1,63 @@
+/*
+Package seclog turns the `logSecurityEvent` sketch in 05_prompt_guard.go's
+and 07_prompt_guard.go's trailing comments (a helper that appends plaintext
+lines to security.log) into a real, structured logger: every event is a
+SecurityEvent serialized as one line of JSON (JSON Lines, so a log file or
+stdout stream can be tailed and parsed line-by-line), and delivery is
+pluggable via the Sink interface so the same event can reach a local file,
+stdout, a webhook (ntfy.sh, Slack, ...), and syslog at once.
+
+This is a logging concern, distinct from pkg/secevents: secevents.Store
+persists verdicts so a caller can later query "what has this identity done
+recently" (promptguard.MiddlewareWithStore writes to one); seclog.Logger is a one-way, fire
+-and-forget audit trail meant for SIEM ingestion or a human watching a
+terminal.
+*/
+package seclog
+
+import "time"
+
+// SecurityEvent is one record worth keeping an audit trail of: a Prompt
+// Guard or LlamaGuard verdict, most commonly.
+type SecurityEvent struct {
+	Timestamp    time.Time `json:"timestamp"`
+	EventType    string    `json:"event_type"` // e.g. "prompt_attack", "content_violation"
+	Verdict      string    `json:"verdict"`    // e.g. "JAILBREAK", "unsafe"
+	Model        string    `json:"model"`
+	RequestID    string    `json:"request_id,omitempty"`
+	UserID       string    `json:"user_id,omitempty"`
+	HashedPrompt string    `json:"hashed_prompt"` // SHA-256 of the prompt, never the prompt itself
+	Categories   []string  `json:"categories,omitempty"`
+	RawResponse  string    `json:"raw_response,omitempty"`
+}
+
+// Sink delivers a SecurityEvent somewhere: a file, stdout, a webhook,
+// syslog. A Sink that fails should return an error rather than panic —
+// Logger keeps going and reports failures from whichever sinks had them.
+type Sink interface {
+	Write(event SecurityEvent) error
+}
+
+// Logger fans every logged SecurityEvent out to a fixed set of Sinks.
+type Logger struct {
+	sinks []Sink
+}
+
+// New builds a Logger writing to every one of sinks.
+func New(sinks ...Sink) *Logger {
+	return &Logger{sinks: sinks}
+}
+
+// Log delivers event to every sink. It attempts delivery to all of them
+// even if one fails, then returns the first error encountered (if any) —
+// one sink being unreachable (a down webhook) shouldn't stop the audit
+// trail from also landing in the local file or syslog.
+func (l *Logger) Log(event SecurityEvent) error {
+	var firstErr error
+	for _, sink := range l.sinks {
+		if err := sink.Write(event); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
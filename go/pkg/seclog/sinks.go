@@ -0,0 +1,144 @@
+package seclog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/syslog"
+	"net/http"
+	"os"
+	"time"
+)
+
+// WriterSink appends each SecurityEvent as one line of JSON to w. FileSink
+// and StdoutSink are both built on it.
+type WriterSink struct {
+	w io.Writer
+}
+
+// NewWriterSink wraps any io.Writer as a Sink.
+func NewWriterSink(w io.Writer) WriterSink {
+	return WriterSink{w: w}
+}
+
+// Write appends event to the underlying writer as one JSON line.
+func (s WriterSink) Write(event SecurityEvent) error {
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal security event: %w", err)
+	}
+	_, err = fmt.Fprintln(s.w, string(raw))
+	return err
+}
+
+// FileSink appends JSON Lines to a file on disk, creating it if it doesn't
+// exist yet — the structured replacement for the security.log sketch in
+// 05_prompt_guard.go's trailing comments.
+type FileSink struct {
+	file *os.File
+	WriterSink
+}
+
+// NewFileSink opens (or creates) path for appending and returns a Sink
+// backed by it. Callers should Close it when done logging.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open security log file: %w", err)
+	}
+	return &FileSink{file: f, WriterSink: NewWriterSink(f)}, nil
+}
+
+// Close closes the underlying file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// StdoutSink writes each SecurityEvent as a JSON line to stdout, for
+// local runs and examples where a human is watching the terminal.
+type StdoutSink struct {
+	WriterSink
+}
+
+// NewStdoutSink builds a Sink that writes to os.Stdout.
+func NewStdoutSink() StdoutSink {
+	return StdoutSink{WriterSink: NewWriterSink(os.Stdout)}
+}
+
+// WebhookSink POSTs each SecurityEvent as a JSON body to a webhook URL —
+// ntfy.sh (see 08_tavily_search.go) and Slack's incoming-webhook endpoints
+// both accept a POST body and will surface it as a notification; a
+// Slack-specific {"text": ...} envelope can be layered on top by wrapping
+// this Sink if a deployment needs Slack's exact payload shape.
+type WebhookSink struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewWebhookSink builds a WebhookSink posting to url.
+func NewWebhookSink(url string) WebhookSink {
+	return WebhookSink{url: url, httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Write POSTs event to the webhook URL as JSON.
+func (s WebhookSink) Write(event SecurityEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal security event: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook sink: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// SyslogSink forwards each SecurityEvent's JSON encoding to the system log
+// via log/syslog, so it shows up alongside every other daemon's logs and
+// can be picked up by whatever log shipper already watches syslog.
+type SyslogSink struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogSink dials the local syslog daemon, tagging entries with tag
+// (e.g. "ai101-promptguard") at LOG_AUTH|LOG_WARNING, the facility/severity
+// combination syslog reserves for security and authorization events.
+func NewSyslogSink(tag string) (*SyslogSink, error) {
+	w, err := syslog.New(syslog.LOG_AUTH|syslog.LOG_WARNING, tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	return &SyslogSink{writer: w}, nil
+}
+
+// Write sends event's JSON encoding to syslog. BENIGN-verdict events are
+// logged at Info level; anything else (an attack or content violation) is
+// logged at Warning, since that's what a SIEM alerting rule would key on.
+func (s *SyslogSink) Write(event SecurityEvent) error {
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal security event: %w", err)
+	}
+	if event.Verdict == "BENIGN" {
+		return s.writer.Info(string(raw))
+	}
+	return s.writer.Warning(string(raw))
+}
+
+// Close closes the syslog connection.
+func (s *SyslogSink) Close() error {
+	return s.writer.Close()
+}
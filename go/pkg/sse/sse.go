@@ -0,0 +1,98 @@
+/*
+Package sse reads an OpenAI-compatible text/event-stream response:
+blank-line-delimited blocks of "event: <name>" and "data: <payload>"
+lines, one block per server-sent event. It's the generic sibling of
+pkg/groqclient/stream.go's scanning loop and 01b_stream_anthropic.go's
+splitSSEEvents helper - those two parse their own
+API's event shape inline because each needs is a one-off; Reader exists
+so a new example (see 01c_stream_samba.go, 11c_stream_web_search.go)
+doesn't have to duplicate that parsing loop a third time.
+
+Reader does not interpret Data as JSON - callers unmarshal it into
+whatever chunk type their API uses, same as groqclient.streamChunk does.
+*/
+package sse
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+)
+
+// Event is one server-sent event: an optional named Event type (most
+// OpenAI-compatible APIs omit it, sending bare "data:" lines; some send
+// "event: error" ahead of an error payload) and its Data payload, with
+// the leading "data: " prefix already stripped from every line and
+// multi-line payloads joined with "\n" per the SSE spec.
+type Event struct {
+	Event string
+	Data  string
+}
+
+// Done is the sentinel payload OpenAI-compatible streams send as the
+// final event to mark a clean end of stream.
+const Done = "[DONE]"
+
+// ErrStreamDone is returned by Reader.Next once it has delivered the
+// terminal "data: [DONE]" event (that event's Data is not itself
+// returned - callers just stop reading). It is equivalent in spirit to
+// io.EOF for a stream that ended normally rather than by the connection
+// closing.
+var ErrStreamDone = errors.New("sse: stream ended with [DONE]")
+
+// Reader scans an SSE body one event at a time.
+type Reader struct {
+	scanner *bufio.Scanner
+}
+
+// NewReader wraps r (typically an http.Response.Body) in a Reader.
+func NewReader(r io.Reader) *Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	return &Reader{scanner: scanner}
+}
+
+// Next reads and returns the next event. It returns ErrStreamDone on a
+// "data: [DONE]" sentinel, or the underlying scan error (io.EOF included)
+// when the connection closes without one.
+func (r *Reader) Next() (Event, error) {
+	var event Event
+	var dataLines []string
+
+	for r.scanner.Scan() {
+		line := strings.TrimRight(r.scanner.Text(), "\r")
+
+		if line == "" {
+			if len(dataLines) == 0 && event.Event == "" {
+				continue // blank line between events, nothing accumulated yet
+			}
+			event.Data = strings.Join(dataLines, "\n")
+			if event.Data == Done {
+				return Event{}, ErrStreamDone
+			}
+			return event, nil
+		}
+
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event.Event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			dataLines = append(dataLines, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+		// Any other field (id:, retry:, comments starting with ":") is
+		// ignored - none of this repo's examples need them.
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return Event{}, err
+	}
+	if len(dataLines) > 0 || event.Event != "" {
+		event.Data = strings.Join(dataLines, "\n")
+		if event.Data == Done {
+			return Event{}, ErrStreamDone
+		}
+		return event, nil
+	}
+	return Event{}, io.EOF
+}
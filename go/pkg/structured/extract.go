@@ -0,0 +1,83 @@
+package structured
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/llm"
+)
+
+// DefaultMaxRetries is how many times Extract re-prompts the model after
+// a JSON-parse or validation failure before giving up.
+const DefaultMaxRetries = 3
+
+// Extract asks provider to answer prompt as a single JSON object
+// matching T's jsonschema/validate tags, unmarshals the reply into a T,
+// and checks it with Validate. On a parse or validation failure, it
+// re-prompts up to DefaultMaxRetries more times with the error text
+// appended so the model can see what it got wrong, returning the last
+// error if every attempt fails.
+//
+// The schema is sent the same way regardless of provider: injected into
+// the system prompt alongside an instruction to reply with JSON only.
+// OpenAI's own endpoint additionally understands response_format:
+// {"type":"json_schema",...} for server-enforced structure, but Groq,
+// Demeterics, and Mistral (also OpenAIProvider, just a different
+// BaseURL) don't, and Anthropic has no equivalent at all - one
+// prompt-injection path that works identically across every
+// llm.Provider is simpler than branching on provider capability for a
+// gain only one of five backends would see, and Validate still catches
+// what the prompt doesn't.
+func Extract[T any](ctx context.Context, provider llm.Provider, prompt string) (T, error) {
+	var zero T
+	schema := SchemaFor(reflect.TypeOf(zero))
+	schemaJSON, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return zero, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	system := fmt.Sprintf("Reply with a single JSON object matching exactly this JSON Schema, and nothing else - no prose, no markdown code fences:\n\n%s", schemaJSON)
+
+	var lastErr error
+	for attempt := 0; attempt <= DefaultMaxRetries; attempt++ {
+		userPrompt := prompt
+		if lastErr != nil {
+			userPrompt = fmt.Sprintf("%s\n\nYour previous reply was invalid: %s\nReply again with corrected JSON only.", prompt, lastErr)
+		}
+
+		resp, err := provider.Chat(ctx, llm.ChatRequest{
+			System:    system,
+			Messages:  []llm.Message{{Role: "user", Content: userPrompt}},
+			MaxTokens: 1024,
+		})
+		if err != nil {
+			return zero, err
+		}
+
+		var result T
+		if err := json.Unmarshal([]byte(stripCodeFence(resp.Text)), &result); err != nil {
+			lastErr = fmt.Errorf("invalid JSON: %w", err)
+			continue
+		}
+		if err := Validate(result); err != nil {
+			lastErr = err
+			continue
+		}
+		return result, nil
+	}
+	return zero, fmt.Errorf("failed after %d attempts: %w", DefaultMaxRetries+1, lastErr)
+}
+
+// stripCodeFence trims a leading/trailing markdown code fence some
+// models add despite being told not to, so json.Unmarshal sees just the
+// object.
+func stripCodeFence(text string) string {
+	text = strings.TrimSpace(text)
+	text = strings.TrimPrefix(text, "```json")
+	text = strings.TrimPrefix(text, "```")
+	text = strings.TrimSuffix(text, "```")
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,102 @@
+package structured
+
+import (
+	"reflect"
+	"strings"
+)
+
+// SchemaFor reflects t (a struct type) into a JSON Schema object,
+// reading each field's name from its json tag and its title/description/
+// enum/required from its jsonschema tag (see package doc for the
+// supported subset).
+func SchemaFor(t reflect.Type) map[string]interface{} {
+	properties := map[string]interface{}{}
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+		name, prop, isRequired := fieldSchema(field)
+		properties[name] = prop
+		if isRequired {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// fieldSchema builds one property entry plus whether the jsonschema tag
+// marked it required.
+func fieldSchema(field reflect.StructField) (name string, prop map[string]interface{}, required bool) {
+	name = field.Name
+	if jsonTag := field.Tag.Get("json"); jsonTag != "" {
+		if n, _, _ := strings.Cut(jsonTag, ","); n != "" && n != "-" {
+			name = n
+		}
+	}
+
+	switch {
+	case field.Type.Kind() == reflect.Struct:
+		prop = SchemaFor(field.Type)
+	case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Struct:
+		prop = map[string]interface{}{"type": "array", "items": SchemaFor(field.Type.Elem())}
+	case field.Type.Kind() == reflect.Slice:
+		prop = map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": jsonType(field.Type.Elem())}}
+	default:
+		prop = map[string]interface{}{"type": jsonType(field.Type)}
+	}
+
+	for _, tag := range strings.Split(field.Tag.Get("jsonschema"), ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if tag == "required" {
+			required = true
+			continue
+		}
+		key, value, ok := strings.Cut(tag, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "title", "description":
+			prop[key] = value
+		case "enum":
+			values := strings.Split(value, "|")
+			enum := make([]interface{}, len(values))
+			for i, v := range values {
+				enum[i] = v
+			}
+			prop["enum"] = enum
+		}
+	}
+	return name, prop, required
+}
+
+// jsonType maps a Go kind to its JSON Schema "type" name.
+func jsonType(k reflect.Type) string {
+	switch k.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return "string"
+	}
+}
@@ -0,0 +1,25 @@
+/*
+Package structured extracts a validated Go value from an LLM's freeform
+reply: define T with jsonschema/validate struct tags, then call
+Extract[T](ctx, provider, prompt) to get a T back instead of hand-parsing
+prose or re-deriving a one-off JSON prompt per example, the way
+13_structured_extraction.go demonstrates with a Person{Name, Age,
+Occupation}.
+
+The tag conventions (jsonschema:"title=...,description=...,enum=a|b",
+validate:"required,min=0,max=150") follow invopop/jsonschema and
+go-playground/validator, but this package doesn't vendor either: this
+repo is stdlib-only, and both are third-party modules. SchemaFor (see
+schema.go) and Validate (see validate.go) are small hand-rolled stand-ins
+covering the subset of each library's tag syntax this repo's examples
+need - title/description/enum/required for schema generation, and
+required/min/max for validation - not their full tag grammars.
+
+Extract (see extract.go) sends the reflected schema to the model by
+injecting it into the system prompt, the one path that works identically
+whether the provider is OpenAI's own endpoint (which additionally
+understands response_format: {"type":"json_schema",...}) or Groq/
+Demeterics/Mistral/Anthropic (which don't) - see Extract's doc comment
+for why that's one path instead of branching per provider.
+*/
+package structured
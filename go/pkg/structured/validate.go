@@ -0,0 +1,98 @@
+package structured
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Validate checks v (a struct) against its fields' validate struct tags:
+// required (the field isn't its type's zero value), min and max
+// (numeric bounds, or string/slice length for those kinds). It returns
+// the first rule that fails, or nil if every field passes.
+func Validate(v interface{}) error {
+	return validateValue(reflect.ValueOf(v))
+}
+
+func validateValue(val reflect.Value) error {
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+	t := val.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+		fieldVal := val.Field(i)
+
+		for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+			rule = strings.TrimSpace(rule)
+			if rule == "" {
+				continue
+			}
+			if err := checkRule(field.Name, fieldVal, rule); err != nil {
+				return err
+			}
+		}
+
+		if fieldVal.Kind() == reflect.Struct {
+			if err := validateValue(fieldVal); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkRule applies one validate tag rule (e.g. "required", "min=0") to
+// a single field.
+func checkRule(fieldName string, v reflect.Value, rule string) error {
+	if rule == "required" {
+		if v.IsZero() {
+			return fmt.Errorf("%s is required", fieldName)
+		}
+		return nil
+	}
+
+	key, value, ok := strings.Cut(rule, "=")
+	if !ok {
+		return nil
+	}
+	bound, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil
+	}
+
+	switch key {
+	case "min":
+		if n := numericValue(v); n < bound {
+			return fmt.Errorf("%s must be >= %v, got %v", fieldName, bound, n)
+		}
+	case "max":
+		if n := numericValue(v); n > bound {
+			return fmt.Errorf("%s must be <= %v, got %v", fieldName, bound, n)
+		}
+	}
+	return nil
+}
+
+// numericValue reads v as a float64 for min/max comparison, treating a
+// string or slice's length as its magnitude.
+func numericValue(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return float64(len(v.String()))
+	case reflect.Slice, reflect.Array:
+		return float64(v.Len())
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,183 @@
+package tavily
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/patdeg/ai101/go/pkg/notify"
+)
+
+// Options configures BatchExtract.
+type Options struct {
+	// Concurrency bounds how many /extract calls are in flight at once.
+	// Defaults to 4.
+	Concurrency int
+	// ExtractDepth is passed through to each single-URL ExtractRequest.
+	// Defaults to "advanced".
+	ExtractDepth string
+	// IncludeImages is passed through to each single-URL ExtractRequest.
+	IncludeImages bool
+	// OutputDir, if set, writes each successfully extracted URL's
+	// raw_content to its own file under this directory instead of
+	// holding it in the Result.
+	OutputDir string
+}
+
+func (o Options) concurrency() int {
+	if o.Concurrency > 0 {
+		return o.Concurrency
+	}
+	return 4
+}
+
+func (o Options) extractDepth() string {
+	if o.ExtractDepth != "" {
+		return o.ExtractDepth
+	}
+	return "advanced"
+}
+
+// Result is one URL's outcome from BatchExtract.
+type Result struct {
+	URL        string
+	RawContent string // set unless Options.OutputDir was used
+	FilePath   string // set if Options.OutputDir was used
+	Images     []string
+	Err        error
+}
+
+// BatchExtract fans req.URLs out to up to opts.Concurrency concurrent
+// /extract calls, bounded by a semaphore, and sends one Result per URL on
+// the returned channel as it completes (not in input order). The channel
+// is closed once every URL has been attempted. Canceling ctx stops
+// launching new requests and aborts in-flight ones.
+func (c *Client) BatchExtract(ctx context.Context, urls []string, opts Options) (<-chan Result, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no URLs to extract")
+	}
+
+	if opts.OutputDir != "" {
+		if err := os.MkdirAll(opts.OutputDir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+
+	results := make(chan Result)
+	sem := make(chan struct{}, opts.concurrency())
+
+	go func() {
+		var wg sync.WaitGroup
+		defer func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		for _, url := range urls {
+			select {
+			case <-ctx.Done():
+				results <- Result{URL: url, Err: ctx.Err()}
+				continue
+			case sem <- struct{}{}:
+			}
+
+			wg.Add(1)
+			go func(url string) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				results <- c.extractOne(ctx, url, opts)
+			}(url)
+		}
+	}()
+
+	return results, nil
+}
+
+// extractOne extracts a single URL and, if opts.OutputDir is set, streams
+// its raw_content straight to a per-URL file rather than returning it in
+// Result.
+func (c *Client) extractOne(ctx context.Context, url string, opts Options) Result {
+	resp, err := c.Extract(ctx, ExtractRequest{
+		URLs:          []string{url},
+		IncludeImages: opts.IncludeImages,
+		ExtractDepth:  opts.extractDepth(),
+	})
+	if err != nil {
+		c.notifyFailure(ctx, url, err)
+		return Result{URL: url, Err: err}
+	}
+	if len(resp.Results) == 0 {
+		err := fmt.Errorf("tavily reported no result for %s", url)
+		c.notifyFailure(ctx, url, err)
+		return Result{URL: url, Err: err}
+	}
+	extracted := resp.Results[0]
+
+	if opts.OutputDir == "" {
+		return Result{URL: url, RawContent: extracted.RawContent, Images: extracted.Images}
+	}
+
+	path := filepath.Join(opts.OutputDir, filenameFor(url))
+	if err := writeFile(path, extracted.RawContent); err != nil {
+		c.notifyFailure(ctx, url, err)
+		return Result{URL: url, Err: err}
+	}
+	return Result{URL: url, FilePath: path, Images: extracted.Images}
+}
+
+// notifyFailure sends a notify.Event for a URL BatchExtract failed to
+// extract. A no-op if c.Notifier is unset.
+func (c *Client) notifyFailure(ctx context.Context, url string, cause error) {
+	if c.Notifier == nil {
+		return
+	}
+	event := notify.Event{
+		Type:      "extraction_failed",
+		Severity:  notify.SeverityWarn,
+		Message:   fmt.Sprintf("failed to extract %s: %v", url, cause),
+		Timestamp: time.Now(),
+		Fields: map[string]string{
+			"url":        url,
+			"request_id": notify.RequestIDFromContext(ctx),
+		},
+	}
+	if err := c.Notifier.Send(ctx, event); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to send extraction-failure notification: %v\n", err)
+	}
+}
+
+func writeFile(path, content string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer f.Close()
+
+	if _, err := io.WriteString(f, content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+var unsafeFilenameChars = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+
+// filenameFor turns url into a filesystem-safe .md filename, so a batch of
+// hundreds of URLs can each land in their own file under OutputDir without
+// collisions from slashes or query strings.
+func filenameFor(url string) string {
+	name := unsafeFilenameChars.ReplaceAllString(url, "_")
+	name = strings.Trim(name, "_")
+	if len(name) > 200 {
+		name = name[:200]
+	}
+	if name == "" {
+		name = "page"
+	}
+	return name + ".md"
+}
@@ -0,0 +1,64 @@
+package tts
+
+// DefaultMaxChars is the chunk size SplitIntoChunks uses when the caller
+// passes maxChars <= 0, comfortably under Groq's per-request input limit.
+const DefaultMaxChars = 2000
+
+// SplitIntoChunks splits text into chunks of at most maxChars characters
+// each, breaking only on sentence boundaries so a chunk never cuts a
+// sentence in half. A single sentence longer than maxChars is still
+// emitted whole rather than sliced mid-word.
+func SplitIntoChunks(text string, maxChars int) []string {
+	if maxChars <= 0 {
+		maxChars = DefaultMaxChars
+	}
+
+	sentences := splitSentences(text)
+
+	var chunks []string
+	var current string
+	for _, sentence := range sentences {
+		if current != "" && len(current)+len(sentence) > maxChars {
+			chunks = append(chunks, current)
+			current = ""
+		}
+		current += sentence
+	}
+	if current != "" {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+// splitSentences splits text right after a '.', '!', or '?' that is
+// followed by whitespace or end-of-string, keeping the terminator and any
+// trailing whitespace attached to the sentence it ends - so "3.14"
+// doesn't split on its decimal point.
+func splitSentences(text string) []string {
+	var sentences []string
+	start := 0
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c != '.' && c != '!' && c != '?' {
+			continue
+		}
+		next := i + 1
+		if next < len(text) && !isSpace(text[next]) {
+			continue
+		}
+		for next < len(text) && isSpace(text[next]) {
+			next++
+		}
+		sentences = append(sentences, text[start:next])
+		start = next
+		i = next - 1
+	}
+	if start < len(text) {
+		sentences = append(sentences, text[start:])
+	}
+	return sentences
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\n' || b == '\t' || b == '\r'
+}
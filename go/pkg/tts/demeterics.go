@@ -0,0 +1,118 @@
+package tts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/patdeg/ai101/go/pkg/httpx"
+)
+
+// DemetericsBaseURL is Demeterics' multi-speaker podcast-style
+// text-to-speech endpoint, the one 16_podcast.go called by hand before
+// it moved onto this package.
+const DemetericsBaseURL = "https://api.demeterics.com/tts/v1/generate"
+
+// Speaker names one voice in a MultiSpeakerRequest's cast.
+type Speaker struct {
+	Name  string
+	Voice string
+}
+
+// MultiSpeakerRequest synthesizes a scripted conversation in a single
+// call - Demeterics' multi-speaker shape, which doesn't fit Synthesizer's
+// one-voice SpeechRequest and so isn't built from one.
+type MultiSpeakerRequest struct {
+	Provider string // Demeterics' underlying model provider, e.g. "gemini"
+	Script   string // lines of "Speaker: text"; every Speaker name must appear in Speakers
+	Speakers []Speaker
+}
+
+// validateScript errors if Script references a speaker name that isn't
+// in Speakers, so a typo fails fast instead of Demeterics silently
+// dropping or mispronouncing that line.
+func (r MultiSpeakerRequest) validateScript() error {
+	known := make(map[string]bool, len(r.Speakers))
+	for _, s := range r.Speakers {
+		known[s.Name] = true
+	}
+
+	for i, raw := range strings.Split(r.Script, "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" {
+			continue
+		}
+		name, _, ok := strings.Cut(line, ":")
+		if !ok {
+			return fmt.Errorf("script line %d has no \"Speaker: text\" separator: %q", i+1, raw)
+		}
+		if name = strings.TrimSpace(name); !known[name] {
+			return fmt.Errorf("script line %d references speaker %q, not in Speakers", i+1, name)
+		}
+	}
+	return nil
+}
+
+// demetericsRequest is the JSON body Demeterics' /tts/v1/generate expects.
+type demetericsRequest struct {
+	Provider string              `json:"provider"`
+	Input    string              `json:"input"`
+	Speakers []demetericsSpeaker `json:"speakers"`
+}
+
+type demetericsSpeaker struct {
+	Name  string `json:"name"`
+	Voice string `json:"voice"`
+}
+
+// SynthesizeMultiSpeaker validates req.Script against req.Speakers, posts
+// it to Demeterics' multi-speaker endpoint, and streams the raw audio
+// bytes to w via io.Copy, returning the byte count - Demeterics returns
+// audio bytes directly, not a JSON envelope.
+func SynthesizeMultiSpeaker(ctx context.Context, apiKey string, req MultiSpeakerRequest, w io.Writer) (int64, error) {
+	if err := req.validateScript(); err != nil {
+		return 0, err
+	}
+
+	speakers := make([]demetericsSpeaker, len(req.Speakers))
+	for i, s := range req.Speakers {
+		speakers[i] = demetericsSpeaker{Name: s.Name, Voice: s.Voice}
+	}
+
+	body, err := json.Marshal(demetericsRequest{
+		Provider: req.Provider,
+		Input:    req.Script,
+		Speakers: speakers,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal podcast request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, DemetericsBaseURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build podcast request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+apiKey)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	client, err := httpx.DefaultClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build HTTP client: %w", err)
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("podcast request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(resp.Body)
+		return 0, fmt.Errorf("podcast request returned status %d: %s", resp.StatusCode, errBody)
+	}
+
+	return io.Copy(w, resp.Body)
+}
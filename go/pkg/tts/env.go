@@ -0,0 +1,38 @@
+package tts
+
+import (
+	"fmt"
+	"os"
+)
+
+// EnvTTSBackend selects which Synthesizer backend FromEnv builds: openai
+// (default), groq, or local.
+const EnvTTSBackend = "TTS_BACKEND"
+
+// FromEnv builds the Synthesizer named by TTS_BACKEND, defaulting to
+// OpenAI if unset, mirroring pkg/sandbox's FromEnv pattern.
+func FromEnv() (Synthesizer, error) {
+	backend := os.Getenv(EnvTTSBackend)
+	if backend == "" {
+		backend = "openai"
+	}
+
+	switch backend {
+	case "openai":
+		apiKey := os.Getenv("OPENAI_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("TTS_BACKEND=openai requires OPENAI_API_KEY")
+		}
+		return NewOpenAISynthesizer(apiKey), nil
+	case "groq":
+		apiKey := os.Getenv("GROQ_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("TTS_BACKEND=groq requires GROQ_API_KEY")
+		}
+		return NewGroqSynthesizer(apiKey), nil
+	case "local":
+		return NewLocalSynthesizer()
+	default:
+		return nil, fmt.Errorf("unknown %s %q (want openai, groq, or local)", EnvTTSBackend, backend)
+	}
+}
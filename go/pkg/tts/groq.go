@@ -0,0 +1,33 @@
+package tts
+
+import (
+	"context"
+	"io"
+)
+
+// GroqSynthesizer implements Synthesizer against Groq's /audio/speech
+// endpoint by delegating to Synthesize - a thin adapter so callers that
+// pick a backend via the Synthesizer interface (e.g. FromEnv) can select
+// Groq the same way they'd select OpenAISynthesizer, while 08_tts.go
+// keeps calling Synthesize directly.
+type GroqSynthesizer struct {
+	APIKey string
+	Model  string // defaults to DefaultModel
+}
+
+// NewGroqSynthesizer builds a GroqSynthesizer using DefaultModel.
+func NewGroqSynthesizer(apiKey string) *GroqSynthesizer {
+	return &GroqSynthesizer{APIKey: apiKey}
+}
+
+// StreamTo implements Synthesizer. Speed and Instructions are silently
+// ignored: Groq's /audio/speech endpoint has no equivalent parameters.
+func (s *GroqSynthesizer) StreamTo(ctx context.Context, req SpeechRequest, w io.Writer) error {
+	_, err := Synthesize(ctx, s.APIKey, Request{
+		Model:          s.Model,
+		Input:          req.Input,
+		Voice:          req.Voice,
+		ResponseFormat: req.format(),
+	}, w)
+	return err
+}
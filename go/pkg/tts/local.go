@@ -0,0 +1,16 @@
+package tts
+
+import "fmt"
+
+// NewLocalSynthesizer would back the "local" TTS_BACKEND for fully
+// offline speech synthesis, but this repo is stdlib-only and Go's
+// standard library ships no TTS model or vocoder: a real local backend
+// needs either cgo bindings to a C++ engine (e.g. espeak-ng, Piper) or a
+// vendored pure-Go model runtime, both outside this repo's
+// zero-dependency convention. Rather than silently writing something
+// that isn't speech to w, this returns an error naming both real
+// options so a caller who genuinely needs offline synthesis can vendor
+// one and swap this function's body for a real implementation.
+func NewLocalSynthesizer() (Synthesizer, error) {
+	return nil, fmt.Errorf("local TTS backend requires a vendored synthesis engine (e.g. espeak-ng via cgo, or a pure-Go Piper runtime), which this stdlib-only repo doesn't vendor; use TTS_BACKEND=openai or TTS_BACKEND=groq for a dependency-free backend")
+}
@@ -0,0 +1,84 @@
+package tts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/patdeg/ai101/go/pkg/httpx"
+)
+
+// OpenAIBaseURL is OpenAI's text-to-speech endpoint, the one
+// 15_text_to_speech.go called by hand before it moved onto this package.
+const OpenAIBaseURL = "https://api.openai.com/v1/audio/speech"
+
+// OpenAIDefaultModel is OpenAI's cheaper, instructable TTS model.
+const OpenAIDefaultModel = "gpt-4o-mini-tts"
+
+// OpenAISynthesizer implements Synthesizer against OpenAI's /audio/speech
+// endpoint.
+type OpenAISynthesizer struct {
+	APIKey string
+	Model  string // defaults to OpenAIDefaultModel
+}
+
+// NewOpenAISynthesizer builds an OpenAISynthesizer using OpenAIDefaultModel.
+func NewOpenAISynthesizer(apiKey string) *OpenAISynthesizer {
+	return &OpenAISynthesizer{APIKey: apiKey}
+}
+
+func (s *OpenAISynthesizer) model() string {
+	if s.Model != "" {
+		return s.Model
+	}
+	return OpenAIDefaultModel
+}
+
+// StreamTo implements Synthesizer. Speed and Instructions are sent
+// whenever set; gpt-4o-mini-tts is the model this repo uses that honors
+// Instructions (older models like tts-1 silently ignore it).
+func (s *OpenAISynthesizer) StreamTo(ctx context.Context, req SpeechRequest, w io.Writer) error {
+	body := map[string]interface{}{
+		"model":           s.model(),
+		"input":           req.Input,
+		"voice":           req.Voice,
+		"response_format": string(req.format()),
+		"speed":           req.speed(),
+	}
+	if req.Instructions != "" {
+		body["instructions"] = req.Instructions
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal speech request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, OpenAIBaseURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build speech request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+s.APIKey)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	client, err := httpx.DefaultClient()
+	if err != nil {
+		return fmt.Errorf("failed to build HTTP client: %w", err)
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("speech request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("speech request returned status %d: %s", resp.StatusCode, errBody)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
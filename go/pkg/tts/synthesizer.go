@@ -0,0 +1,63 @@
+package tts
+
+import (
+	"context"
+	"io"
+	"os"
+)
+
+// SpeechRequest configures a Synthesizer.StreamTo call. Voice and
+// ResponseFormat are honored by every backend; Speed and Instructions are
+// best-effort hints a backend may ignore if its API doesn't support them
+// (GroqSynthesizer ignores both).
+type SpeechRequest struct {
+	Input          string
+	Voice          string
+	ResponseFormat Format  // defaults to FormatMP3
+	Speed          float64 // defaults to 1.0
+	Instructions   string  // tone/delivery guidance, e.g. "speak slowly and calmly"
+}
+
+func (r SpeechRequest) format() Format {
+	if r.ResponseFormat != "" {
+		return r.ResponseFormat
+	}
+	return FormatMP3
+}
+
+func (r SpeechRequest) speed() float64 {
+	if r.Speed != 0 {
+		return r.Speed
+	}
+	return 1.0
+}
+
+// Synthesizer turns a SpeechRequest into audio, flushed to w as chunks
+// arrive rather than buffered whole in memory first, so callers can pick
+// a backend (FromEnv) instead of hardcoding one provider's HTTP glue.
+type Synthesizer interface {
+	StreamTo(ctx context.Context, req SpeechRequest, w io.Writer) error
+}
+
+// SynthesizeToFile streams synth's audio for req straight to filename via
+// Synthesizer.StreamTo, then stats the file it just wrote to report its
+// size - no buffering the whole response in memory first. Exported so
+// both 15_text_to_speech.go and 15b_roundtrip.go (each its own
+// standalone `go run` file) can share it instead of redefining it.
+func SynthesizeToFile(ctx context.Context, synth Synthesizer, req SpeechRequest, filename string) (int64, error) {
+	f, err := os.Create(filename)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	if err := synth.StreamTo(ctx, req, f); err != nil {
+		return 0, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
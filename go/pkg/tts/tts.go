@@ -0,0 +1,112 @@
+/*
+Package tts is a typed client for Groq's /audio/speech text-to-speech
+endpoint - the inverse of pkg/whisper's /audio/transcriptions: Synthesize
+posts text and a voice and streams the raw audio bytes back via io.Copy,
+the same shape 08_tts.go uses to write them straight to disk.
+
+Long input is chunked on sentence boundaries by SplitIntoChunks (see
+chunk.go), since speech models - like Whisper - have an input size limit;
+08_tts.go synthesizes each chunk in turn and concatenates the results into
+one audio file.
+
+Synthesize predates the provider-agnostic Synthesizer interface (see
+synthesizer.go) and is kept as-is so 08_tts.go doesn't have to change;
+GroqSynthesizer (groq.go) wraps it to also satisfy Synthesizer alongside
+OpenAISynthesizer (openai.go). Demeterics' multi-speaker endpoint doesn't
+fit Synthesizer's single-voice shape and is handled separately by
+SynthesizeMultiSpeaker (demeterics.go).
+*/
+package tts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/patdeg/ai101/go/pkg/httpx"
+)
+
+// BaseURL is Groq's text-to-speech endpoint.
+const BaseURL = "https://api.groq.com/openai/v1/audio/speech"
+
+// DefaultModel is Groq's PlayAI-backed text-to-speech model.
+const DefaultModel = "playai-tts"
+
+// Format is the audio response_format a text-to-speech backend accepts.
+// Groq's /audio/speech only supports mp3/wav/opus/flac; OpenAISynthesizer
+// additionally accepts FormatAAC and FormatPCM.
+type Format string
+
+const (
+	FormatMP3  Format = "mp3"
+	FormatWAV  Format = "wav"
+	FormatOpus Format = "opus"
+	FormatFLAC Format = "flac"
+	FormatAAC  Format = "aac"
+	FormatPCM  Format = "pcm"
+)
+
+// Request configures a Synthesize call.
+type Request struct {
+	Model          string // defaults to DefaultModel
+	Input          string
+	Voice          string
+	ResponseFormat Format // defaults to FormatMP3
+}
+
+func (r Request) model() string {
+	if r.Model != "" {
+		return r.Model
+	}
+	return DefaultModel
+}
+
+func (r Request) format() Format {
+	if r.ResponseFormat != "" {
+		return r.ResponseFormat
+	}
+	return FormatMP3
+}
+
+// Synthesize posts req to Groq's /audio/speech endpoint and streams the
+// raw audio response body into w via io.Copy, returning the number of
+// bytes written. Callers chunking long input can call Synthesize once per
+// chunk with the same w to concatenate the resulting audio into one file.
+func Synthesize(ctx context.Context, apiKey string, req Request, w io.Writer) (int64, error) {
+	body, err := json.Marshal(map[string]string{
+		"model":           req.model(),
+		"input":           req.Input,
+		"voice":           req.Voice,
+		"response_format": string(req.format()),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal speech request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, BaseURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build speech request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+apiKey)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	client, err := httpx.DefaultClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build HTTP client: %w", err)
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("speech request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(resp.Body)
+		return 0, fmt.Errorf("speech request returned status %d: %s", resp.StatusCode, errBody)
+	}
+
+	return io.Copy(w, resp.Body)
+}
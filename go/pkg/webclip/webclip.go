@@ -0,0 +1,168 @@
+/*
+Package webclip turns a Tavily extraction into a proper read-later clip:
+it absolutizes any relative or protocol-relative image and link URLs
+against the source page, and fetches the source page once more to pull
+its OpenGraph metadata for a YAML front-matter header.
+
+Modeled on the fix Mattermost shipped for GetOpenGraphMetadata, which
+ensured every URL in parsed OG metadata was absolute before use - applied
+here to Tavily's extracted Markdown rather than to OG output itself.
+
+This repo sticks to the standard library only, so metadata extraction
+below is regexp-based rather than a full HTML tokenizer: good enough for
+the handful of well-known <title>/<meta property="og:..."> shapes this
+package looks for, not a general HTML parser.
+*/
+package webclip
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Metadata is what FetchMetadata pulls from a page's <head>.
+type Metadata struct {
+	Title       string
+	Description string
+	SiteName    string
+	Image       string
+}
+
+var titleTag = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+
+var ogTags = map[string]*regexp.Regexp{
+	"title":       compileOGTag("title"),
+	"description": compileOGTag("description"),
+	"image":       compileOGTag("image"),
+	"site_name":   compileOGTag("site_name"),
+}
+
+// compileOGTag matches <meta property="og:name" content="..."> with either
+// attribute order, since pages aren't consistent about it.
+func compileOGTag(name string) *regexp.Regexp {
+	return regexp.MustCompile(
+		`(?is)<meta[^>]+(?:property=["']og:` + name + `["'][^>]+content=["']([^"']*)["']` +
+			`|content=["']([^"']*)["'][^>]+property=["']og:` + name + `["'])`,
+	)
+}
+
+// matchOGTag returns the first non-empty capture group matched by name's
+// pattern, decoding HTML entities in it, or "" if it's not present.
+func matchOGTag(pageHTML, name string) string {
+	m := ogTags[name].FindStringSubmatch(pageHTML)
+	if m == nil {
+		return ""
+	}
+	for _, group := range m[1:] {
+		if group != "" {
+			return html.UnescapeString(strings.TrimSpace(group))
+		}
+	}
+	return ""
+}
+
+// FetchMetadata does a lightweight GET of pageURL and extracts its OG meta
+// tags, falling back to <title> for Title if no og:title tag is present.
+func FetchMetadata(client *http.Client, pageURL string) (Metadata, error) {
+	resp, err := client.Get(pageURL)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("failed to fetch %s: %w", pageURL, err)
+	}
+	defer resp.Body.Close()
+
+	// 1 MiB is plenty to cover any page's <head>; pages with oversized
+	// heads aren't worth buffering in full for a metadata lookup.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return Metadata{}, fmt.Errorf("failed to read %s: %w", pageURL, err)
+	}
+	pageHTML := string(body)
+
+	meta := Metadata{
+		Title:       matchOGTag(pageHTML, "title"),
+		Description: matchOGTag(pageHTML, "description"),
+		SiteName:    matchOGTag(pageHTML, "site_name"),
+		Image:       matchOGTag(pageHTML, "image"),
+	}
+	if meta.Title == "" {
+		if m := titleTag.FindStringSubmatch(pageHTML); m != nil {
+			meta.Title = html.UnescapeString(strings.TrimSpace(m[1]))
+		}
+	}
+	return meta, nil
+}
+
+// markdownLink matches both Markdown links (`[text](href)`) and images
+// (`![alt](href)`); group 1 is the optional leading "!", group 2 the
+// text/alt, group 3 the href.
+var markdownLink = regexp.MustCompile(`(!?)\[([^\]]*)\]\(([^)\s]+)\)`)
+
+// AbsolutizeURL resolves href against base if it's relative or
+// protocol-relative, returning it unchanged if it's already absolute or
+// unparseable.
+func AbsolutizeURL(href string, base *url.URL) string {
+	parsed, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	return base.ResolveReference(parsed).String()
+}
+
+// AbsolutizeURLs applies AbsolutizeURL to every entry in urls.
+func AbsolutizeURLs(urls []string, base *url.URL) []string {
+	out := make([]string, len(urls))
+	for i, u := range urls {
+		out[i] = AbsolutizeURL(u, base)
+	}
+	return out
+}
+
+// AbsolutizeMarkdown rewrites every Markdown link/image target in md that
+// is relative or protocol-relative into an absolute URL, resolved against
+// base (the page md was extracted from).
+func AbsolutizeMarkdown(md string, base *url.URL) string {
+	return markdownLink.ReplaceAllStringFunc(md, func(match string) string {
+		parts := markdownLink.FindStringSubmatch(match)
+		bang, text, href := parts[1], parts[2], parts[3]
+		return fmt.Sprintf("%s[%s](%s)", bang, text, AbsolutizeURL(href, base))
+	})
+}
+
+// FrontMatter builds a YAML front-matter block (title, description,
+// source, retrieved_at, images) meant to be prepended to a saved Markdown
+// clip, turning raw extracted Markdown into a proper read-later record.
+func FrontMatter(meta Metadata, source string, retrievedAt time.Time, images []string) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "title: %s\n", yamlScalar(meta.Title))
+	fmt.Fprintf(&b, "description: %s\n", yamlScalar(meta.Description))
+	if meta.SiteName != "" {
+		fmt.Fprintf(&b, "site_name: %s\n", yamlScalar(meta.SiteName))
+	}
+	fmt.Fprintf(&b, "source: %s\n", yamlScalar(source))
+	fmt.Fprintf(&b, "retrieved_at: %s\n", retrievedAt.Format(time.RFC3339))
+	if len(images) == 0 {
+		b.WriteString("images: []\n")
+	} else {
+		b.WriteString("images:\n")
+		for _, img := range images {
+			fmt.Fprintf(&b, "  - %s\n", yamlScalar(img))
+		}
+	}
+	b.WriteString("---\n\n")
+	return b.String()
+}
+
+// yamlScalar quotes s as a YAML double-quoted scalar, escaping the two
+// characters that would otherwise break it.
+func yamlScalar(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
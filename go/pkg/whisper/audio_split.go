@@ -0,0 +1,196 @@
+package whisper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// DefaultMaxBytes is the chunk size SplitAudioByDuration uses when the
+// caller passes maxBytes <= 0, comfortably under Groq's 25 MB per-request
+// limit.
+const DefaultMaxBytes = 24 * 1024 * 1024
+
+// SplitAudioByDuration splits raw MP3 or WAV bytes into chunks of at most
+// maxBytes each, with the trailing overlapSeconds of one chunk repeated
+// at the start of the next so a word spanning a chunk boundary still
+// appears whole in at least one chunk's transcript. It never shells out
+// to ffmpeg: MP3 files are split along frame sync-word boundaries, and
+// WAV files along PCM sample boundaries inside the "data" chunk (each
+// resulting chunk gets its own RIFF/fmt/data header so it's a standalone
+// playable WAV file).
+func SplitAudioByDuration(data []byte, maxBytes int, overlapSeconds float64) ([][]byte, error) {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+	if len(data) <= maxBytes {
+		return [][]byte{data}, nil
+	}
+
+	if bytes.HasPrefix(data, []byte("RIFF")) {
+		return splitWAV(data, maxBytes, overlapSeconds)
+	}
+	return splitMP3(data, maxBytes, overlapSeconds)
+}
+
+var mp3BitratesKbps = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+var mp3SampleRates = [4]int{44100, 48000, 32000, 0}
+
+// mp3FrameSize parses an MPEG-1 Layer III frame header at data[i] and
+// returns its size in bytes and its bitrate in kbps. ok is false if
+// data[i] isn't a valid frame sync word (0xFFE as the first 11 bits).
+func mp3FrameSize(data []byte, i int) (size, bitrateKbps int, ok bool) {
+	if i+4 > len(data) {
+		return 0, 0, false
+	}
+	if data[i] != 0xFF || data[i+1]&0xE0 != 0xE0 {
+		return 0, 0, false
+	}
+
+	bitrateIndex := (data[i+2] >> 4) & 0x0F
+	sampleRateIndex := (data[i+2] >> 2) & 0x03
+	padding := (data[i+2] >> 1) & 0x01
+
+	bitrate := mp3BitratesKbps[bitrateIndex]
+	sampleRate := mp3SampleRates[sampleRateIndex]
+	if bitrate == 0 || sampleRate == 0 {
+		return 0, 0, false
+	}
+
+	size = 144*bitrate*1000/sampleRate + int(padding)
+	return size, bitrate, true
+}
+
+// splitMP3 scans data for MP3 frame sync words, greedily groups
+// consecutive frames into chunks of at most maxBytes, and backs each
+// chunk boundary (after the first) up by the frames covering
+// overlapSeconds at the preceding chunk's bitrate.
+func splitMP3(data []byte, maxBytes int, overlapSeconds float64) ([][]byte, error) {
+	type frame struct{ start, size, bitrateKbps int }
+
+	var frames []frame
+	for i := 0; i < len(data); {
+		size, bitrateKbps, ok := mp3FrameSize(data, i)
+		if !ok {
+			i++
+			continue
+		}
+		frames = append(frames, frame{start: i, size: size, bitrateKbps: bitrateKbps})
+		i += size
+	}
+	if len(frames) == 0 {
+		return nil, fmt.Errorf("no MP3 frame sync words found in audio data")
+	}
+
+	var chunks [][]byte
+	start := 0
+	for start < len(frames) {
+		end := start
+		for end+1 < len(frames) && frames[end+1].start+frames[end+1].size-frames[start].start <= maxBytes {
+			end++
+		}
+		chunks = append(chunks, data[frames[start].start:frames[end].start+frames[end].size])
+
+		if end == len(frames)-1 {
+			break
+		}
+
+		overlapBytes := int(float64(frames[end].bitrateKbps) * 1000 / 8 * overlapSeconds)
+		next := end
+		for next > start && frames[end].start-frames[next].start < overlapBytes {
+			next--
+		}
+		// next >= start, so the next chunk always begins at least one
+		// frame later and the loop terminates.
+		start = next + 1
+	}
+	return chunks, nil
+}
+
+// splitWAV parses data's RIFF/fmt/data chunks and splits the PCM samples
+// into chunks of at most maxBytes, each rebuilt as a standalone WAV file
+// via buildWAVChunk.
+func splitWAV(data []byte, maxBytes int, overlapSeconds float64) ([][]byte, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("not a valid WAV file")
+	}
+
+	var blockAlign int
+	var byteRate int
+	var dataTagStart, dataStart, dataSize int
+
+	offset := 12
+	for offset+8 <= len(data) {
+		chunkID := string(data[offset : offset+4])
+		chunkSize := int(binary.LittleEndian.Uint32(data[offset+4 : offset+8]))
+		chunkBody := offset + 8
+
+		switch chunkID {
+		case "fmt ":
+			if chunkBody+16 > len(data) {
+				return nil, fmt.Errorf("truncated WAV fmt chunk")
+			}
+			blockAlign = int(binary.LittleEndian.Uint16(data[chunkBody+12 : chunkBody+14]))
+			byteRate = int(binary.LittleEndian.Uint32(data[chunkBody+8 : chunkBody+12]))
+		case "data":
+			dataTagStart = offset
+			dataStart = chunkBody
+			dataSize = chunkSize
+		}
+
+		if chunkID == "data" {
+			break
+		}
+		offset = chunkBody + chunkSize + chunkSize%2 // chunks are word-aligned
+	}
+	if dataStart == 0 || blockAlign == 0 {
+		return nil, fmt.Errorf("WAV file missing fmt or data chunk")
+	}
+	if dataStart+dataSize > len(data) {
+		dataSize = len(data) - dataStart
+	}
+
+	header := data[:dataTagStart] // everything up to (not including) the data chunk's own "data" tag + size field
+	maxSampleBytes := maxBytes - len(header) - 8
+	if maxSampleBytes <= 0 {
+		return nil, fmt.Errorf("maxBytes too small to fit a WAV header")
+	}
+	maxSampleBytes -= maxSampleBytes % blockAlign
+
+	overlapBytes := int(overlapSeconds * float64(byteRate))
+	overlapBytes -= overlapBytes % blockAlign
+
+	var chunks [][]byte
+	for start := 0; start < dataSize; {
+		end := start + maxSampleBytes
+		if end > dataSize {
+			end = dataSize
+		}
+		chunks = append(chunks, buildWAVChunk(header, data[dataStart+start:dataStart+end]))
+		if end >= dataSize {
+			break
+		}
+		start = end - overlapBytes
+		if start < 0 {
+			start = 0
+		}
+	}
+	return chunks, nil
+}
+
+// buildWAVChunk reassembles header (the RIFF header, fmt chunk, and any
+// chunks that precede "data") with a fresh "data" chunk around samples,
+// patching the RIFF size field so the result is a standalone, playable
+// WAV file.
+func buildWAVChunk(header, samples []byte) []byte {
+	out := make([]byte, 0, len(header)+8+len(samples))
+	out = append(out, header...)
+	out = append(out, []byte("data")...)
+	var sizeField [4]byte
+	binary.LittleEndian.PutUint32(sizeField[:], uint32(len(samples)))
+	out = append(out, sizeField[:]...)
+	out = append(out, samples...)
+
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+	return out
+}
@@ -0,0 +1,129 @@
+package whisper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// buildTestWAV assembles a minimal PCM WAV file (RIFF/fmt/data, no extra
+// chunks) wrapping numSamples bytes of synthetic, distinguishable sample
+// data (each byte is its own index mod 256), for round-tripping through
+// SplitAudioByDuration.
+func buildTestWAV(t *testing.T, numSamples int) []byte {
+	t.Helper()
+
+	const (
+		channels      = 1
+		bitsPerSample = 8
+		sampleRate    = 16000
+	)
+	blockAlign := channels * bitsPerSample / 8
+	byteRate := sampleRate * blockAlign
+
+	samples := make([]byte, numSamples)
+	for i := range samples {
+		samples[i] = byte(i)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	binary.Write(&buf, binary.LittleEndian, uint32(36+len(samples)))
+	buf.WriteString("WAVE")
+
+	buf.WriteString("fmt ")
+	binary.Write(&buf, binary.LittleEndian, uint32(16))
+	binary.Write(&buf, binary.LittleEndian, uint16(1)) // PCM
+	binary.Write(&buf, binary.LittleEndian, uint16(channels))
+	binary.Write(&buf, binary.LittleEndian, uint32(sampleRate))
+	binary.Write(&buf, binary.LittleEndian, uint32(byteRate))
+	binary.Write(&buf, binary.LittleEndian, uint16(blockAlign))
+	binary.Write(&buf, binary.LittleEndian, uint16(bitsPerSample))
+
+	buf.WriteString("data")
+	binary.Write(&buf, binary.LittleEndian, uint32(len(samples)))
+	buf.Write(samples)
+
+	return buf.Bytes()
+}
+
+// parseWAVDataChunk parses data's RIFF/fmt/data chunks, failing the test
+// if there isn't exactly one well-formed "data" chunk, and returns its
+// payload - so a split chunk's validity can be checked the same way a
+// real WAV decoder would.
+func parseWAVDataChunk(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		t.Fatalf("not a valid WAV file (bad RIFF/WAVE header)")
+	}
+	riffSize := int(binary.LittleEndian.Uint32(data[4:8]))
+	if riffSize != len(data)-8 {
+		t.Fatalf("RIFF size field = %d, want %d (len(data)-8)", riffSize, len(data)-8)
+	}
+
+	var dataChunks int
+	var payload []byte
+	offset := 12
+	for offset+8 <= len(data) {
+		chunkID := string(data[offset : offset+4])
+		chunkSize := int(binary.LittleEndian.Uint32(data[offset+4 : offset+8]))
+		chunkBody := offset + 8
+		if chunkBody+chunkSize > len(data) {
+			t.Fatalf("chunk %q size %d overruns buffer (only %d bytes left)", chunkID, chunkSize, len(data)-chunkBody)
+		}
+		if chunkID == "data" {
+			dataChunks++
+			payload = data[chunkBody : chunkBody+chunkSize]
+		}
+		offset = chunkBody + chunkSize + chunkSize%2
+	}
+	if dataChunks != 1 {
+		t.Fatalf("found %d \"data\" chunks, want exactly 1", dataChunks)
+	}
+	return payload
+}
+
+func TestSplitAudioByDurationWAVRoundTrip(t *testing.T) {
+	original := buildTestWAV(t, 100000)
+
+	chunks, err := SplitAudioByDuration(original, 40000, 0)
+	if err != nil {
+		t.Fatalf("SplitAudioByDuration: %v", err)
+	}
+	if len(chunks) < 2 {
+		t.Fatalf("got %d chunks, want at least 2 for a 100000-byte WAV split at 40000 bytes", len(chunks))
+	}
+
+	var reassembled []byte
+	for i, chunk := range chunks {
+		payload := parseWAVDataChunk(t, chunk)
+		if len(payload) == 0 {
+			t.Fatalf("chunk %d: empty payload", i)
+		}
+		reassembled = append(reassembled, payload...)
+	}
+
+	originalSamples := original[len(original)-100000:]
+	if !bytes.Equal(reassembled, originalSamples) {
+		t.Fatalf("reassembled samples don't match original (got %d bytes, want %d)", len(reassembled), len(originalSamples))
+	}
+}
+
+func TestSplitAudioByDurationWAVOverlap(t *testing.T) {
+	original := buildTestWAV(t, 100000)
+
+	chunks, err := SplitAudioByDuration(original, 40000, 0.5)
+	if err != nil {
+		t.Fatalf("SplitAudioByDuration: %v", err)
+	}
+	if len(chunks) < 2 {
+		t.Fatalf("got %d chunks, want at least 2", len(chunks))
+	}
+	for i, chunk := range chunks {
+		parseWAVDataChunk(t, chunk)
+		if len(chunk) > 40000 {
+			t.Errorf("chunk %d is %d bytes, want <= 40000", i, len(chunk))
+		}
+	}
+}
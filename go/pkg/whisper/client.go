@@ -0,0 +1,28 @@
+package whisper
+
+// AudioClient is a Groq API key bound to both Whisper endpoints, so
+// callers that need to both transcribe and translate audio (as
+// 07b_translate.go does) don't have to pass the key to two free
+// functions.
+type AudioClient struct {
+	APIKey string
+}
+
+// NewAudioClient returns an AudioClient authenticated with apiKey.
+func NewAudioClient(apiKey string) *AudioClient {
+	return &AudioClient{APIKey: apiKey}
+}
+
+// Transcribe uploads req.AudioPath to Groq's transcription endpoint and
+// returns text in the audio's own language.
+func (c *AudioClient) Transcribe(req Request) (*TranscriptionResponse, error) {
+	return uploadAudio(c.APIKey, TranscriptionsURL, req)
+}
+
+// Translate uploads req.AudioPath to Groq's translation endpoint and
+// always returns English text, regardless of the audio's source
+// language. req.Language is ignored by this endpoint since the source
+// language is auto-detected.
+func (c *AudioClient) Translate(req Request) (*TranscriptionResponse, error) {
+	return uploadAudio(c.APIKey, TranslationsURL, req)
+}
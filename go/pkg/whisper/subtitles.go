@@ -0,0 +1,43 @@
+package whisper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SRT renders r's segments as SubRip subtitles: sequential cue numbering
+// and "HH:MM:SS,mmm" timestamps.
+func (r *TranscriptionResponse) SRT() string {
+	var b strings.Builder
+	for i, seg := range r.Segments {
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n", i+1, formatTimestamp(seg.Start, ","), formatTimestamp(seg.End, ","), strings.TrimSpace(seg.Text))
+	}
+	return b.String()
+}
+
+// VTT renders r's segments as WebVTT subtitles: the required "WEBVTT"
+// header and "HH:MM:SS.mmm" timestamps.
+func (r *TranscriptionResponse) VTT() string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n\n")
+	for _, seg := range r.Segments {
+		fmt.Fprintf(&b, "%s --> %s\n%s\n\n", formatTimestamp(seg.Start, "."), formatTimestamp(seg.End, "."), strings.TrimSpace(seg.Text))
+	}
+	return b.String()
+}
+
+// formatTimestamp renders seconds as HH:MM:SS<sep>mmm, sep being "," for
+// SRT and "." for WebVTT.
+func formatTimestamp(seconds float64, sep string) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+	totalMillis := int64(seconds*1000 + 0.5)
+	hours := totalMillis / 3_600_000
+	totalMillis %= 3_600_000
+	minutes := totalMillis / 60_000
+	totalMillis %= 60_000
+	secs := totalMillis / 1000
+	millis := totalMillis % 1000
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", hours, minutes, secs, sep, millis)
+}
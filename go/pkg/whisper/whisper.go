@@ -0,0 +1,212 @@
+/*
+Package whisper is a typed client for Groq's Whisper audio transcription
+and translation endpoints, replacing the ad-hoc multipart.Writer plumbing
+and text-only TranscriptionResponse that used to live directly in
+08_whisper.go.
+
+TranscriptionResponse covers the full verbose_json schema - segments and
+word-level timestamps, not just text/duration - so callers can render SRT
+or WebVTT subtitles (see subtitles.go) from the same response the
+"text"/"json" formats use. SplitAudioByDuration (see audio_split.go) keeps
+large files under Groq's per-request size limit without shelling out to
+ffmpeg.
+*/
+package whisper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/patdeg/ai101/go/pkg/httpx"
+)
+
+// TranscriptionsURL is Groq's audio transcription endpoint. It returns
+// text in the audio's own language.
+const TranscriptionsURL = "https://api.groq.com/openai/v1/audio/transcriptions"
+
+// TranslationsURL is Groq's audio translation endpoint. It accepts audio
+// in any supported language and always returns English text.
+const TranslationsURL = "https://api.groq.com/openai/v1/audio/translations"
+
+// DefaultModel is the fast, cost-effective Whisper model this module's
+// examples use.
+const DefaultModel = "whisper-large-v3-turbo"
+
+// Format is the transcription response_format Groq's API accepts.
+type Format string
+
+const (
+	FormatJSON        Format = "json"
+	FormatVerboseJSON Format = "verbose_json"
+	FormatText        Format = "text"
+	FormatSRT         Format = "srt"
+	FormatVTT         Format = "vtt"
+)
+
+// Granularity is one level of timestamp detail requestable via the
+// timestamp_granularities[] form field.
+type Granularity string
+
+const (
+	GranularityWord    Granularity = "word"
+	GranularitySegment Granularity = "segment"
+)
+
+// Segment is one verbose_json transcription segment.
+type Segment struct {
+	ID           int     `json:"id"`
+	Start        float64 `json:"start"`
+	End          float64 `json:"end"`
+	Text         string  `json:"text"`
+	AvgLogprob   float64 `json:"avg_logprob"`
+	NoSpeechProb float64 `json:"no_speech_prob"`
+	Temperature  float64 `json:"temperature"`
+}
+
+// Word is one verbose_json word-level timestamp.
+type Word struct {
+	Word  string  `json:"word"`
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+}
+
+// TranscriptionResponse is Groq's verbose_json transcription response.
+// Segments is populated when Request.TimestampGranularities includes
+// GranularitySegment, and Words when it includes GranularityWord; both
+// are empty for a plain FormatJSON request.
+type TranscriptionResponse struct {
+	Task     string    `json:"task"`
+	Language string    `json:"language"`
+	Duration float64   `json:"duration"`
+	Text     string    `json:"text"`
+	Segments []Segment `json:"segments,omitempty"`
+	Words    []Word    `json:"words,omitempty"`
+}
+
+// Request configures a Transcribe call.
+type Request struct {
+	AudioPath              string
+	Model                  string // defaults to DefaultModel
+	Format                 Format // defaults to FormatVerboseJSON
+	Language               string
+	Temperature            float64
+	TimestampGranularities []Granularity
+}
+
+func (r Request) model() string {
+	if r.Model != "" {
+		return r.Model
+	}
+	return DefaultModel
+}
+
+func (r Request) format() Format {
+	if r.Format != "" {
+		return r.Format
+	}
+	return FormatVerboseJSON
+}
+
+// Transcribe uploads req.AudioPath to Groq's Whisper transcription
+// endpoint and parses the result. It is a convenience wrapper around
+// AudioClient.Transcribe for callers that don't need to reuse a client
+// across multiple calls.
+func Transcribe(apiKey string, req Request) (*TranscriptionResponse, error) {
+	return NewAudioClient(apiKey).Transcribe(req)
+}
+
+// uploadAudio uploads req.AudioPath to the given Whisper endpoint (either
+// TranscriptionsURL or TranslationsURL) and parses the result. Groq's API
+// only knows json/verbose_json/text/srt/vtt as its own response_format;
+// FormatSRT and FormatVTT are rendered locally (see subtitles.go) from a
+// verbose_json response instead, so uploadAudio requests verbose_json
+// under the hood when the caller asked for subtitles and returns the
+// parsed response; callers render it with SRT or VTT.
+func uploadAudio(apiKey, url string, req Request) (*TranscriptionResponse, error) {
+	file, err := os.Open(req.AudioPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audio file: %w", err)
+	}
+	defer file.Close()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile("file", filepath.Base(req.AudioPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create form file: %w", err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, fmt.Errorf("failed to copy audio content: %w", err)
+	}
+
+	if err := writer.WriteField("model", req.model()); err != nil {
+		return nil, fmt.Errorf("failed to write model field: %w", err)
+	}
+
+	apiFormat := req.format()
+	if apiFormat == FormatSRT || apiFormat == FormatVTT {
+		apiFormat = FormatVerboseJSON
+	}
+	if err := writer.WriteField("response_format", string(apiFormat)); err != nil {
+		return nil, fmt.Errorf("failed to write response_format field: %w", err)
+	}
+
+	if req.Language != "" {
+		if err := writer.WriteField("language", req.Language); err != nil {
+			return nil, fmt.Errorf("failed to write language field: %w", err)
+		}
+	}
+	if req.Temperature != 0 {
+		if err := writer.WriteField("temperature", fmt.Sprintf("%g", req.Temperature)); err != nil {
+			return nil, fmt.Errorf("failed to write temperature field: %w", err)
+		}
+	}
+	for _, granularity := range req.TimestampGranularities {
+		if err := writer.WriteField("timestamp_granularities[]", string(granularity)); err != nil {
+			return nil, fmt.Errorf("failed to write timestamp_granularities field: %w", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, url, &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+apiKey)
+	httpReq.Header.Set("Content-Type", writer.FormDataContentType())
+
+	client, err := httpx.DefaultClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build HTTP client: %w", err)
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("audio request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if apiFormat == FormatText {
+		return &TranscriptionResponse{Text: string(respBody)}, nil
+	}
+
+	var result TranscriptionResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w (raw: %s)", err, respBody)
+	}
+	return &result, nil
+}